@@ -0,0 +1,332 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+// attributes the PS3.15 basic profile removes outright, table E.1-1,
+// where the table leaves a choice between removing and emptying or
+// replacing an attribute it's removed
+var anonRemove = []tag.Tag{
+	tag.ACR_NEMA_AcquisitionComments,
+	tag.ACR_NEMA_IdentifyingComments,
+	tag.ACR_NEMA_ImagePresentationComments,
+	tag.ACR_NEMA_InsurancePlanIdentification,
+	tag.ACR_NEMA_ModifiedImageDescription,
+	tag.ACR_NEMA_ModifyingDeviceID,
+	tag.ACR_NEMA_ModifyingDeviceManufacturer,
+	tag.ACR_NEMA_TextArbitrary,
+	tag.ACR_NEMA_TextComments,
+	tag.AcquisitionContextSequence,
+	tag.AcquisitionDate,
+	tag.AcquisitionDateTime,
+	tag.AcquisitionDeviceProcessingDescription,
+	tag.AcquisitionProtocolDescription,
+	tag.AcquisitionTime,
+	tag.ActualHumanPerformersSequence,
+	tag.AdditionalPatientHistory,
+	tag.AdmissionID,
+	tag.AdmittingDate,
+	tag.AdmittingDiagnosesCodeSequence,
+	tag.AdmittingDiagnosesDescription,
+	tag.AdmittingTime,
+	tag.AffectedSOPInstanceUID,
+	tag.Allergies,
+	tag.AuthorObserverSequence,
+	tag.BranchOfService,
+	tag.CassetteID,
+	tag.CommentsOnThePerformedProcedureStep,
+	tag.ConfidentialityConstraintOnPatientDataDescription,
+	tag.ContentCreatorIdentificationCodeSequence,
+	tag.ContentSequence,
+	tag.ContributionDescription,
+	tag.CountryOfResidence,
+	tag.CurrentPatientLocation,
+	tag.CustodialOrganizationSequence,
+	tag.DataSetTrailingPadding,
+	tag.DerivationDescription,
+	tag.DetectorID,
+	tag.DeviceSerialNumber,
+	tag.DigitalSignatureUID,
+	tag.DigitalSignaturesSequence,
+	tag.EndAcquisitionDateTime,
+	tag.EthnicGroup,
+	tag.ExpectedCompletionDateTime,
+	tag.FrameComments,
+	tag.GantryID,
+	tag.GeneratorID,
+	tag.GraphicAnnotationSequence,
+	tag.HumanPerformerName,
+	tag.HumanPerformerOrganization,
+	tag.IconImageSequence,
+	tag.ImageComments,
+	tag.ImagingServiceRequestComments,
+	tag.InstitutionAddress,
+	tag.InstitutionCodeSequence,
+	tag.InstitutionName,
+	tag.InstitutionalDepartmentName,
+	tag.IntendedRecipientsOfResultsIdentificationSequence,
+	tag.IssuerOfPatientID,
+	tag.LastMenstrualDate,
+	tag.MAC,
+	tag.MedicalAlerts,
+	tag.MedicalRecordLocator,
+	tag.MilitaryRank,
+	tag.ModifiedAttributesSequence,
+	tag.NameOfPhysiciansReadingStudy,
+	tag.NamesOfIntendedRecipientsOfResults,
+	tag.Occupation,
+	tag.OperatorIdentificationSequence,
+	tag.OperatorsName,
+	tag.OrderCallbackPhoneNumber,
+	tag.OrderEnteredBy,
+	tag.OrderEntererLocation,
+	tag.OriginalAttributesSequence,
+	tag.OtherPatientIDs,
+	tag.OtherPatientIDsSequence,
+	tag.OtherPatientNames,
+	tag.ParticipantSequence,
+	tag.PatientAddress,
+	tag.PatientAge,
+	tag.PatientBirthName,
+	tag.PatientBirthTime,
+	tag.PatientComments,
+	tag.PatientInstitutionResidence,
+	tag.PatientInsurancePlanCodeSequence,
+	tag.PatientMotherBirthName,
+	tag.PatientPrimaryLanguageCodeSequence,
+	tag.PatientPrimaryLanguageModifierCodeSequence,
+	tag.PatientReligiousPreference,
+	tag.PatientSexNeutered,
+	tag.PatientSize,
+	tag.PatientState,
+	tag.PatientTelephoneNumbers,
+	tag.PatientTransportArrangements,
+	tag.PatientWeight,
+	tag.PerformedLocation,
+	tag.PerformedProcedureStepDescription,
+	tag.PerformedProcedureStepEndDate,
+	tag.PerformedProcedureStepEndDateTime,
+	tag.PerformedProcedureStepEndTime,
+	tag.PerformedProcedureStepID,
+	tag.PerformedProcedureStepStartDate,
+	tag.PerformedProcedureStepStartDateTime,
+	tag.PerformedProcedureStepStartTime,
+	tag.PerformedStationAETitle,
+	tag.PerformedStationGeographicLocationCodeSequence,
+	tag.PerformedStationName,
+	tag.PerformedStationNameCodeSequence,
+	tag.PerformingPhysicianIdentificationSequence,
+	tag.PerformingPhysicianName,
+	tag.PersonAddress,
+	tag.PersonIdentificationCodeSequence,
+	tag.PersonTelephoneNumbers,
+	tag.PhysiciansOfRecord,
+	tag.PhysiciansOfRecordIdentificationSequence,
+	tag.PhysiciansReadingStudyIdentificationSequence,
+	tag.PreMedication,
+	tag.PregnancyStatus,
+	tag.ProtocolName,
+	tag.ReferencedDigitalSignatureSequence,
+	tag.ReferencedPatientAliasSequence,
+	tag.ReferencedPatientSequence,
+	tag.ReferencedPerformedProcedureStepSequence,
+	tag.ReferencedSOPInstanceMACSequence,
+	tag.ReferencedStudySequence,
+	tag.ReferringPhysicianAddress,
+	tag.ReferringPhysicianIdentificationSequence,
+	tag.ReferringPhysicianTelephoneNumbers,
+	tag.RegionOfResidence,
+	tag.RequestAttributesSequence,
+	tag.RequestedContrastAgent,
+	tag.RequestedProcedureComments,
+	tag.RequestedProcedureDescription,
+	tag.RequestedProcedureID,
+	tag.RequestedProcedureLocation,
+	tag.RequestingPhysician,
+	tag.RequestingService,
+	tag.ResponsibleOrganization,
+	tag.ResponsiblePerson,
+	tag.ReviewerName,
+	tag.ScheduledHumanPerformersSequence,
+	tag.ScheduledPerformingPhysicianIdentificationSequence,
+	tag.ScheduledPerformingPhysicianName,
+	tag.ScheduledProcedureStepDescription,
+	tag.ScheduledProcedureStepEndDate,
+	tag.ScheduledProcedureStepEndTime,
+	tag.ScheduledProcedureStepLocation,
+	tag.ScheduledProcedureStepStartDate,
+	tag.ScheduledProcedureStepStartTime,
+	tag.ScheduledStationAETitle,
+	tag.ScheduledStationGeographicLocationCodeSequence,
+	tag.ScheduledStationName,
+	tag.ScheduledStationNameCodeSequence,
+	tag.SeriesDate,
+	tag.SeriesDescription,
+	tag.SeriesTime,
+	tag.ServiceEpisodeDescription,
+	tag.ServiceEpisodeID,
+	tag.SmokingStatus,
+	tag.SpecialNeeds,
+	tag.StationName,
+	tag.StudyDescription,
+	tag.TextString,
+	tag.TimezoneOffsetFromUTC,
+	tag.VerifyingObserverSequence,
+	tag.VerifyingOrganization,
+	tag.VisitComments,
+
+	// retired ones the tag dictionary has no names for
+	{Group: 0x0008, Element: 0x0025}, // CurveDate
+	{Group: 0x0008, Element: 0x0035}, // CurveTime
+	{Group: 0x0032, Element: 0x0012}, // StudyIDIssuer
+	{Group: 0x0032, Element: 0x1020}, // ScheduledStudyLocation
+	{Group: 0x0032, Element: 0x1021}, // ScheduledStudyLocationAETitle
+	{Group: 0x0032, Element: 0x1030}, // ReasonForStudy
+	{Group: 0x0032, Element: 0x4000}, // StudyComments
+	{Group: 0x0038, Element: 0x0011}, // IssuerOfAdmissionID
+	{Group: 0x0038, Element: 0x001E}, // ScheduledPatientInstitutionResidence
+	{Group: 0x0038, Element: 0x0040}, // DischargeDiagnosisDescription
+	{Group: 0x0038, Element: 0x0061}, // IssuerOfServiceEpisodeID
+	{Group: 0x0040, Element: 0x2001}, // ReasonForTheImagingServiceRequest
+	{Group: 0x0088, Element: 0x0904}, // TopicTitle
+	{Group: 0x0088, Element: 0x0906}, // TopicSubject
+	{Group: 0x0088, Element: 0x0910}, // TopicAuthor
+	{Group: 0x0088, Element: 0x0912}, // TopicKeywords
+	{Group: 0x4008, Element: 0x0042}, // ResultsIDIssuer
+	{Group: 0x4008, Element: 0x0102}, // InterpretationRecorder
+	{Group: 0x4008, Element: 0x010A}, // InterpretationTranscriber
+	{Group: 0x4008, Element: 0x010B}, // InterpretationText
+	{Group: 0x4008, Element: 0x010C}, // InterpretationAuthor
+	{Group: 0x4008, Element: 0x0111}, // InterpretationApproverSequence
+	{Group: 0x4008, Element: 0x0114}, // PhysicianApprovingInterpretation
+	{Group: 0x4008, Element: 0x0115}, // InterpretationDiagnosisDescription
+	{Group: 0x4008, Element: 0x0118}, // ResultsDistributionListSequence
+	{Group: 0x4008, Element: 0x0119}, // DistributionName
+	{Group: 0x4008, Element: 0x0202}, // InterpretationIDIssuer
+	{Group: 0x4008, Element: 0x0300}, // Impressions
+	{Group: 0x4008, Element: 0x4000}, // ResultsComments
+}
+
+// attributes the basic profile keeps but empties, usually because
+// they're type 2 and have to be present
+var anonEmpty = []tag.Tag{
+	tag.AccessionNumber,
+	tag.ContentCreatorName,
+	tag.ContentDate,
+	tag.ContentTime,
+	tag.ContrastBolusAgent,
+	tag.FillerOrderNumberImagingServiceRequest,
+	tag.PatientBirthDate,
+	tag.PatientID,
+	tag.PatientName,
+	tag.PatientSex,
+	tag.PlacerOrderNumberImagingServiceRequest,
+	tag.ReferringPhysicianName,
+	tag.StudyDate,
+	tag.StudyID,
+	tag.StudyTime,
+	tag.VerifyingObserverIdentificationCodeSequence,
+}
+
+// attributes the basic profile replaces with a dummy value, the one
+// dummy sequences take is left out and those are removed instead
+var anonDummy = []tag.Tag{
+	tag.PersonName,
+	tag.VerifyingObserverName,
+}
+
+// anonymizationKey is the key uids get remapped with, -anonymize-key
+// when it's set or otherwise a random one for this run
+func anonymizationKey() []byte {
+	if *anonymizeKey != "" {
+		return []byte(*anonymizeKey)
+	}
+	key := make([]byte, 32)
+	rand.Read(key)
+	return key
+}
+
+// anonymize strips identifying attributes out of ds in place and
+// remaps its uids with key, then records that it's been done
+func anonymize(ds *dicom.Dataset, key []byte) error {
+	ds.Elements = anonymizeElements(ds.Elements, key)
+
+	_, err := setElement(ds, tag.PatientIdentityRemoved, []string{"YES"})
+	if err != nil {
+		return err
+	}
+	_, err = setElement(ds, tag.DeidentificationMethod, []string{"PS3.15 Basic Application Level Confidentiality Profile"})
+	return err
+}
+
+func anonymizeElements(elems []*dicom.Element, key []byte) []*dicom.Element {
+	return slices.DeleteFunc(elems, func(elem *dicom.Element) bool {
+		if tag.IsPrivate(elem.Tag.Group) || slices.Contains(anonRemove, elem.Tag) || anonRepeating(elem.Tag) {
+			return true
+		}
+
+		switch {
+		case slices.Contains(anonEmpty, elem.Tag) && elem.Value.ValueType() == dicom.Sequences:
+			elem.Value, _ = dicom.NewValue([][]*dicom.Element{})
+		case slices.Contains(anonEmpty, elem.Tag):
+			elem.Value, _ = dicom.NewValue([]string{""})
+		case slices.Contains(anonDummy, elem.Tag):
+			elem.Value, _ = dicom.NewValue([]string{"ANONYMOUS"})
+		case elem.Value.ValueType() == dicom.Strings && elem.RawValueRepresentation == "UI":
+			vals := slices.Clone(elem.Value.GetValue().([]string))
+			for i, v := range vals {
+				vals[i] = anonymizeUID(key, v)
+			}
+			elem.Value, _ = dicom.NewValue(vals)
+		case elem.Value.ValueType() == dicom.Sequences:
+			var items [][]*dicom.Element
+			for _, item := range elem.Value.GetValue().([]*dicom.SequenceItemValue) {
+				items = append(items, anonymizeElements(item.GetValue().([]*dicom.Element), key))
+			}
+			elem.Value, _ = dicom.NewValue(items)
+		}
+		return false
+	})
+}
+
+// anonRepeating reports whether t is one of the curve attributes in
+// groups 5000-501E or an overlay's data or comments in 6000-601E,
+// which the basic profile removes along with the rest
+func anonRepeating(t tag.Tag) bool {
+	switch {
+	case t.Group >= 0x5000 && t.Group <= 0x501E && t.Group%2 == 0:
+		return true
+	case t.Group >= 0x6000 && t.Group <= 0x601E && t.Group%2 == 0:
+		return t.Element == 0x3000 || t.Element == 0x4000
+	}
+	return false
+}
+
+// anonymizeUID derives a new uid from an hmac of the old one so every
+// instance of a study maps its uids the same way without having to
+// share any state, while nobody without key can tell which uid a
+// remapped one came from by hashing candidates, registry uids like
+// sop classes aren't identifying and are left alone
+func anonymizeUID(key []byte, u string) string {
+	u = strings.TrimRight(u, "\x00 ")
+	if u == "" {
+		return u
+	}
+	if _, err := uid.Lookup(u); err == nil {
+		return u
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(u))
+	return "2.25." + new(big.Int).SetBytes(mac.Sum(nil)[:16]).String()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+func TestAnonymizeBasicProfile(t *testing.T) {
+	ds, err := dicom.ParseFile(xrayFixture, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sop := datasetString(ds, tag.SOPInstanceUID)
+	if err = anonymize(&ds, []byte("key")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tg := range []tag.Tag{tag.StudyDate, tag.StudyTime, tag.PatientName, tag.PatientID} {
+		if elem, err := ds.FindElementByTag(tg); err == nil && datasetString(ds, tg) != "" {
+			t.Errorf("%v kept %v", tg, elem.Value)
+		}
+	}
+	for _, tg := range []tag.Tag{tag.SeriesDate, tag.StudyDescription, tag.SeriesDescription, tag.InstitutionName} {
+		if _, err := ds.FindElementByTag(tg); err == nil {
+			t.Errorf("%v wasn't removed", tg)
+		}
+	}
+
+	got := datasetString(ds, tag.SOPInstanceUID)
+	if got == sop || got != anonymizeUID([]byte("key"), sop) {
+		t.Errorf("SOPInstanceUID %q from %q", got, sop)
+	}
+	if anonymizeUID([]byte("other"), sop) == got {
+		t.Error("uids remap the same under a different key")
+	}
+}
+
+func TestAnonymizeCopyLocation(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"base": xrayFixture})
+
+	rec := send(h, http.MethodPost, "/base/anonymize", nil)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	var copied struct {
+		ID string `json:"id"`
+	}
+	decodeJSON(t, rec, &copied)
+	if got, want := rec.Header().Get("Location"), "http://example.com/"+copied.ID; got != want {
+		t.Errorf("Location %q, want %q", got, want)
+	}
+}
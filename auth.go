@@ -0,0 +1,46 @@
+package main
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BearerAuth turns away any request that doesn't carry token as a
+// bearer token, except on the paths listed in open and those let in
+// on a signed url
+func BearerAuth(token string, open ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if slices.Contains(open, ctx.Request.URL.Path) || ctx.GetBool(sharedKey) {
+			return
+		}
+
+		if !hasBearer(ctx.Request, token) {
+			ctx.Header("WWW-Authenticate", `Bearer realm="dicom"`)
+			ctx.Error(NewStatusError(http.StatusUnauthorized, errors.New("missing or invalid bearer token")))
+			ctx.Abort()
+		}
+	}
+}
+
+// RequireBearer is BearerAuth for handlers served outside gin, which
+// answer in plain text rather than the json errors
+func RequireBearer(token string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !hasBearer(r, token) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="dicom"`)
+			http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func hasBearer(r *http.Request, token string) bool {
+	got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestBearerAuthLeavesProbesOpen(t *testing.T) {
+	*authToken = "secret"
+	t.Cleanup(func() { *authToken = "" })
+	h, _ := newTestRouter(t, nil)
+
+	for _, path := range []string{"/healthz", "/readyz"} {
+		if rec := send(h, http.MethodGet, path, nil); rec.Code != http.StatusOK {
+			t.Errorf("%s: got %d %s", path, rec.Code, rec.Body)
+		}
+	}
+	if rec := send(h, http.MethodGet, "/", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("listing without a token: got %d", rec.Code)
+	}
+	if rec := send(h, http.MethodGet, "/", nil, "Authorization", "Bearer secret"); rec.Code != http.StatusOK {
+		t.Errorf("listing with the token: got %d", rec.Code)
+	}
+	// a bad id is only pointed out to whoever has the token
+	if rec := send(h, http.MethodGet, "/.hidden", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("bad id without a token: got %d", rec.Code)
+	}
+	if rec := send(h, http.MethodGet, "/.hidden", nil, "Authorization", "Bearer secret"); rec.Code != http.StatusBadRequest {
+		t.Errorf("bad id with the token: got %d", rec.Code)
+	}
+}
+
+func TestRequireBearer(t *testing.T) {
+	h := RequireBearer("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	if rec := send(h, http.MethodGet, "/metrics", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("without a token: got %d", rec.Code)
+	}
+	if rec := send(h, http.MethodGet, "/metrics", nil, "Authorization", "Bearer wrong"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("with the wrong token: got %d", rec.Code)
+	}
+	if rec := send(h, http.MethodGet, "/metrics", nil, "Authorization", "Bearer secret"); rec.Code != http.StatusOK {
+		t.Errorf("with the token: got %d", rec.Code)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/suyashkumar/dicom"
+)
+
+// errEntryTooLarge is what an archive entry bigger than -max-upload
+// fails with
+var errEntryTooLarge = NewStatusError(http.StatusRequestEntityTooLarge, errors.New("entry too large"))
+
+// storeZipEntry stores a single file out of a bulk upload archive
+// under id, or its SOPInstanceUID when id is empty, the same way
+// storeInstance does
+func storeZipEntry(ctx context.Context, storage fileStorage, locks *idLocks, f *zip.File, id string) (dicom.Dataset, func(), error) {
+	if id != "" {
+		if err := validID(id); err != nil {
+			return dicom.Dataset{}, nil, NewStatusError(http.StatusBadRequest, err)
+		}
+	}
+	// the sizes in the archive are only a claim, the reader is
+	// capped too so a zip bomb can't fill the disk
+	if f.UncompressedSize64 > uint64(*maxUpload) {
+		return dicom.Dataset{}, nil, errEntryTooLarge
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return dicom.Dataset{}, nil, NewStatusError(http.StatusBadRequest, err)
+	}
+	defer rc.Close()
+	return storeInstance(ctx, storage, locks, &cappedReader{io.LimitReader(rc, *maxUpload+1), *maxUpload}, id)
+}
+
+// cappedReader fails once more than left bytes have come out of r,
+// where io.LimitReader alone would quietly cut an entry short and
+// store what's left of it
+type cappedReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (c *cappedReader) Read(p []byte) (n int, err error) {
+	n, err = c.r.Read(p)
+	c.left -= int64(n)
+	if c.left < 0 {
+		return n, errEntryTooLarge
+	}
+	return
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestCappedReader(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), 10)
+
+	got, err := io.ReadAll(&cappedReader{io.LimitReader(bytes.NewReader(data), 11), 10})
+	if err != nil || len(got) != 10 {
+		t.Errorf("at the limit: read %d, %v", len(got), err)
+	}
+	_, err = io.ReadAll(&cappedReader{io.LimitReader(bytes.NewReader(data), 10), 9})
+	if !errors.Is(err, errEntryTooLarge) {
+		t.Errorf("over the limit: got %v, want %v", err, errEntryTooLarge)
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/suyashkumar/dicom"
+)
+
+// lruCache keeps the most recently used values by id, bounded by the
+// total of what size says each one costs, whatever it hands out is
+// shared and must not be modified
+type lruCache[V any] struct {
+	mu    sync.Mutex
+	max   int64
+	used  int64
+	size  func(V) int64
+	lru   *list.List
+	items map[string]*list.Element
+	// bumped on every invalidation so a parse that raced with a
+	// write doesn't put the old version back
+	gen uint64
+	// tracks used, if anything does
+	gauge prometheus.Gauge
+}
+
+type lruEntry[V any] struct {
+	id   string
+	v    V
+	size int64
+}
+
+func newLRUCache[V any](max int64, size func(V) int64) *lruCache[V] {
+	return &lruCache[V]{max: max, size: size, lru: list.New(), items: map[string]*list.Element{}}
+}
+
+// get gives the value cached for id, on a miss it gives the
+// generation to hand back to add once the value's been worked out
+func (c *lruCache[V]) get(id string) (v V, gen uint64, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[id]
+	if !ok {
+		return v, c.gen, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*lruEntry[V]).v, 0, true
+}
+
+// add caches v for id unless something was invalidated since gen,
+// values too big to ever fit are left out rather than flushing
+// everything
+func (c *lruCache[V]) add(id string, gen uint64, v V) {
+	entry := &lruEntry[V]{id: id, v: v, size: c.size(v)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry.size > c.max || c.gen != gen {
+		return
+	}
+	if e, ok := c.items[id]; ok {
+		c.removeLocked(e)
+	}
+	c.items[id] = c.lru.PushFront(entry)
+	c.used += entry.size
+	for c.used > c.max {
+		c.removeLocked(c.lru.Back())
+	}
+	c.usedChanged()
+}
+
+// remove drops id, to be called whenever it's written
+func (c *lruCache[V]) remove(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	if e, ok := c.items[id]; ok {
+		c.removeLocked(e)
+		c.usedChanged()
+	}
+}
+
+// clear drops everything, for when any file may have changed
+func (c *lruCache[V]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	c.lru.Init()
+	c.items = map[string]*list.Element{}
+	c.used = 0
+	c.usedChanged()
+}
+
+func (c *lruCache[V]) removeLocked(e *list.Element) {
+	entry := e.Value.(*lruEntry[V])
+	c.lru.Remove(e)
+	delete(c.items, entry.id)
+	c.used -= entry.size
+}
+
+func (c *lruCache[V]) usedChanged() {
+	if c.gauge != nil {
+		c.gauge.Set(float64(c.used))
+	}
+}
+
+// datasetCache keeps the most recently used datasets around, parsed
+// without their pixel data, so polling a file for tags doesn't mean
+// reparsing it every time, it holds up to a number of them
+type datasetCache struct {
+	*lruCache[dicom.Dataset]
+}
+
+func newDatasetCache(size int) *datasetCache {
+	return &datasetCache{newLRUCache(int64(size), func(dicom.Dataset) int64 { return 1 })}
+}
+
+// load gives the dataset stored under id, minus the pixel data
+func (c *datasetCache) load(ctx context.Context, storage fileStorage, id string) (dicom.Dataset, error) {
+	ds, gen, ok := c.get(id)
+	if ok {
+		datasetCacheHits.Inc()
+		return ds, nil
+	}
+	datasetCacheMisses.Inc()
+
+	ds, err := parseFile(ctx, storage, id, dicom.SkipPixelData())
+	if err != nil {
+		return ds, err
+	}
+	c.add(id, gen, ds)
+	return ds, nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestLRUCache(t *testing.T) {
+	c := newLRUCache(10, func(v int) int64 { return int64(v) })
+	_, gen, _ := c.get("a")
+	c.add("a", gen, 4)
+	c.add("b", gen, 4)
+	c.get("a")
+	c.add("c", gen, 4)
+	if _, _, ok := c.get("b"); ok {
+		t.Error("least recently used value kept over the limit")
+	}
+	if v, _, ok := c.get("a"); !ok || v != 4 {
+		t.Errorf("got %d, %v for a", v, ok)
+	}
+	c.add("d", gen, 11)
+	if _, _, ok := c.get("d"); ok {
+		t.Error("cached a value bigger than the whole cache")
+	}
+
+	// a value worked out before an invalidation could be stale
+	_, gen, _ = c.get("e")
+	c.remove("a")
+	c.add("e", gen, 1)
+	if _, _, ok := c.get("e"); ok {
+		t.Error("cached a value from before an invalidation")
+	}
+	c.clear()
+	if c.used != 0 || c.lru.Len() != 0 {
+		t.Errorf("%d left in use after clearing", c.used)
+	}
+}
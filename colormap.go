@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"maps"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// colorStop pins a point of a colormap, the colors in between are
+// interpolated
+type colorStop struct {
+	at      float64
+	r, g, b float64
+}
+
+// built in colormaps, laid out like the matplotlib ones they're named
+// after
+var colormapStops = map[string][]colorStop{
+	"hot": {
+		{0, 0, 0, 0}, {1.0 / 3, 1, 0, 0}, {2.0 / 3, 1, 1, 0}, {1, 1, 1, 1},
+	},
+	"jet": {
+		{0, 0, 0, 0.5}, {0.125, 0, 0, 1}, {0.375, 0, 1, 1}, {0.625, 1, 1, 0}, {0.875, 1, 0, 0}, {1, 0.5, 0, 0},
+	},
+	"bone": {
+		{0, 0, 0, 0}, {0.375, 0.32, 0.32, 0.45}, {0.75, 0.65, 0.78, 0.78}, {1, 1, 1, 1},
+	},
+}
+
+// colormaps are worked out once into a palette for every 8 bit value
+var colormaps = map[string]color.Palette{}
+
+func init() {
+	for name, stops := range colormapStops {
+		p := make(color.Palette, 256)
+		for i := range p {
+			p[i] = colormapColor(stops, float64(i)/255)
+		}
+		colormaps[name] = p
+	}
+}
+
+func colormapColor(stops []colorStop, v float64) color.RGBA {
+	i := 1
+	for i < len(stops)-1 && stops[i].at < v {
+		i++
+	}
+	lo, hi := stops[i-1], stops[i]
+	t := (v - lo.at) / (hi.at - lo.at)
+	mix := func(a, b float64) uint8 {
+		return uint8(math.Round(255 * (a + (b-a)*t)))
+	}
+	return color.RGBA{mix(lo.r, hi.r), mix(lo.g, hi.g), mix(lo.b, hi.b), 0xff}
+}
+
+// queryColormap reads ?colormap=, nil means plain grayscale
+func queryColormap(ctx *gin.Context) (color.Palette, error) {
+	name := ctx.Query("colormap")
+	if name == "" || name == "gray" || name == "grey" {
+		return nil, nil
+	}
+	p, ok := colormaps[name]
+	if !ok {
+		return nil, NewStatusError(http.StatusBadRequest, fmt.Errorf("unknown colormap %q, expected one of gray, %s", name, strings.Join(slices.Sorted(maps.Keys(colormaps)), ", ")))
+	}
+	return p, nil
+}
+
+// applyColormap maps grayscale through p into a paletted image, which
+// keeps pngs as small as the grayscale would have been, raw 16 bit
+// frames go by their top 8 bits so they want windowing first, and
+// color frames pass through
+func applyColormap(img image.Image, p color.Palette) image.Image {
+	switch img.(type) {
+	case *image.Gray, *image.Gray16:
+	default:
+		return img
+	}
+
+	b := img.Bounds()
+	out := image.NewPaletted(b, p)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			out.SetColorIndex(x, y, color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y)
+		}
+	}
+	return out
+}
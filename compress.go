@@ -0,0 +1,216 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"math"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// files we compress say so in their gzip header, which tells them
+// apart from uploads that happen to be gzip themselves, so compressed
+// and uncompressed files can sit side by side while storage migrates
+const compressedMarker = "pckthlth-compressed"
+
+// compressing has write go through gzip when storage is compressed
+func compressing(write func(io.Writer) error) func(io.Writer) error {
+	if !*gzipStorage {
+		return write
+	}
+	return func(w io.Writer) error {
+		gz := gzip.NewWriter(w)
+		gz.Comment = compressedMarker
+		if err := write(gz); err != nil {
+			return err
+		}
+		return gz.Close()
+	}
+}
+
+// storedFile reads back a file in storage as it was uploaded,
+// decompressing it on the way if it was stored compressed
+type storedFile struct {
+	file rawFile
+	// nil for files stored as they are
+	gz *gzip.Reader
+	// how far into the decompressed contents gz is
+	pos int64
+	// where the next Read picks up from, gz is only brought there
+	// when it happens so seeking around costs nothing by itself
+	want int64
+	// the decompressed size, -1 until it's been worked out
+	size int64
+
+	// ReadAt's own decompressor and how far into the contents it is,
+	// kept between calls so reading forward through the file doesn't
+	// start over from the top every time
+	atMu  sync.Mutex
+	at    *gzip.Reader
+	atPos int64
+}
+
+func openStored(ctx context.Context, storage fileStorage, name string) (*storedFile, error) {
+	file, err := storage.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	f := &storedFile{file: file, size: -1}
+	gz, err := gzip.NewReader(f.raw())
+	switch {
+	case err == nil && gz.Comment == compressedMarker:
+		f.gz = gz
+	case err == nil, errors.Is(err, gzip.ErrHeader), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		// not one of ours
+	default:
+		file.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// raw reads the file as it is on disk without moving its offset
+func (f *storedFile) raw() io.Reader {
+	return io.NewSectionReader(f.file, 0, math.MaxInt64)
+}
+
+func (f *storedFile) Read(p []byte) (n int, err error) {
+	if f.gz == nil {
+		return f.file.Read(p)
+	}
+	if err = f.catchUp(); err != nil {
+		return
+	}
+	n, err = f.gz.Read(p)
+	f.pos += int64(n)
+	f.want = f.pos
+	return
+}
+
+// catchUp decompresses gz its way to where the last Seek asked for,
+// starting over if that's behind where it is
+func (f *storedFile) catchUp() error {
+	if f.want < f.pos {
+		if err := f.gz.Reset(f.raw()); err != nil {
+			return err
+		}
+		f.pos = 0
+	}
+	n, err := io.CopyN(io.Discard, f.gz, f.want-f.pos)
+	f.pos += n
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// Seek on a compressed file only notes the offset, the next Read gets
+// there
+func (f *storedFile) Seek(offset int64, whence int) (int64, error) {
+	if f.gz == nil {
+		return f.file.Seek(offset, whence)
+	}
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.want
+	case io.SeekEnd:
+		size, err := f.Size()
+		if err != nil {
+			return 0, err
+		}
+		offset += size
+	}
+	if offset < 0 {
+		return 0, errors.New("seek before start of file")
+	}
+	f.want = offset
+	return offset, nil
+}
+
+// ReadAt reads from the uploaded contents, which for a compressed
+// file means decompressing up to off from wherever the last ReadAt
+// left off, or from the top if off is behind that
+func (f *storedFile) ReadAt(p []byte, off int64) (int, error) {
+	if f.gz == nil {
+		return f.file.ReadAt(p, off)
+	}
+	f.atMu.Lock()
+	defer f.atMu.Unlock()
+	if f.at == nil || off < f.atPos {
+		var err error
+		if f.at == nil {
+			f.at, err = gzip.NewReader(f.raw())
+		} else {
+			err = f.at.Reset(f.raw())
+		}
+		if err != nil {
+			f.at = nil
+			return 0, err
+		}
+		f.atPos = 0
+	}
+	skipped, err := io.CopyN(io.Discard, f.at, off-f.atPos)
+	f.atPos += skipped
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(f.at, p)
+	f.atPos += int64(n)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Size is how big the file was when it was uploaded, a compressed
+// file has to be decompressed the first time to find out
+func (f *storedFile) Size() (int64, error) {
+	if f.gz == nil {
+		info, err := f.file.Stat()
+		if err != nil {
+			return 0, err
+		}
+		return info.Size(), nil
+	}
+	if f.size < 0 {
+		gz, err := gzip.NewReader(f.raw())
+		if err != nil {
+			return 0, err
+		}
+		n, err := io.Copy(io.Discard, gz)
+		if err != nil {
+			return 0, err
+		}
+		f.size = n
+	}
+	return f.size, nil
+}
+
+// Stat is the file as it is on disk, which is what tells whether it's
+// changed
+func (f *storedFile) Stat() (fs.FileInfo, error) {
+	return f.file.Stat()
+}
+
+func (f *storedFile) Close() error {
+	return f.file.Close()
+}
+
+// serveStored sends file back as it was uploaded, name only goes to
+// guess the content type if it isn't set already
+func serveStored(ctx *gin.Context, name string, file *storedFile) error {
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	http.ServeContent(ctx.Writer, ctx.Request, name, info.ModTime(), file)
+	return nil
+}
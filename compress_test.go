@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+)
+
+// compressedFile stores data the way -compress-storage does and opens
+// it back up
+func compressedFile(t *testing.T, data []byte) *storedFile {
+	t.Helper()
+	*gzipStorage = true
+	t.Cleanup(func() { *gzipStorage = false })
+	var buf bytes.Buffer
+	err := compressing(func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage := newMemStorage()
+	storage.put("base", buf.Bytes())
+	f, err := openStored(context.Background(), storage, "base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	if f.gz == nil {
+		t.Fatal("file wasn't read back as compressed")
+	}
+	return f
+}
+
+func TestCompressedReadAt(t *testing.T) {
+	data := readFixture(t, xrayFixture)
+	f := compressedFile(t, data)
+
+	// forwards, backwards and past the end
+	for _, off := range []int64{0, 1000, 5000, 132, int64(len(data)) - 10, int64(len(data))} {
+		p := make([]byte, 100)
+		n, err := f.ReadAt(p, off)
+		want := data[min(off, int64(len(data))):min(off+100, int64(len(data)))]
+		if !bytes.Equal(p[:n], want) {
+			t.Errorf("at %d: got %d bytes not matching the file, %v", off, n, err)
+		}
+		if n < len(p) && err != io.EOF {
+			t.Errorf("at %d: short read without io.EOF, %v", off, err)
+		}
+	}
+}
+
+func TestCompressedSeek(t *testing.T) {
+	data := readFixture(t, xrayFixture)
+	f := compressedFile(t, data)
+
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil || end != int64(len(data)) {
+		t.Fatalf("seek to end: got %d, %v", end, err)
+	}
+	for _, off := range []int64{2000, 100} {
+		if _, err := f.Seek(off, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		p := make([]byte, 50)
+		if _, err := io.ReadFull(f, p); err != nil || !bytes.Equal(p, data[off:off+50]) {
+			t.Errorf("read after seeking to %d doesn't match the file, %v", off, err)
+		}
+	}
+	if pos, _ := f.Seek(10, io.SeekCurrent); pos != 160 {
+		t.Errorf("seek from current: got %d, want 160", pos)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// how long a request waits for a turn once the limit's been hit
+// before it's turned away
+const concurrencyWait = 2 * time.Second
+
+// ConcurrencyLimit handles at most n requests at once, past that a
+// request waits up to concurrencyWait for another to finish and gets
+// a 503 if none do, except on the paths listed in open so probes still
+// answer under load
+func ConcurrencyLimit(n int, open ...string) gin.HandlerFunc {
+	slots := make(chan struct{}, n)
+	return func(ctx *gin.Context) {
+		if slices.Contains(open, ctx.Request.URL.Path) {
+			return
+		}
+
+		timer := time.NewTimer(concurrencyWait)
+		defer timer.Stop()
+		select {
+		case slots <- struct{}{}:
+		case <-timer.C:
+			requestRejections.Inc()
+			ctx.Header("Retry-After", "1")
+			ctx.Error(NewStatusError(http.StatusServiceUnavailable, errors.New("too many requests in flight, try again shortly")))
+			ctx.Abort()
+			return
+		case <-ctx.Request.Context().Done():
+			ctx.Abort()
+			return
+		}
+		defer func() { <-slots }()
+		ctx.Next()
+	}
+}
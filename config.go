@@ -0,0 +1,106 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+)
+
+var (
+	listenAddr     = flag.String("addr", ":8080", "address to listen on")
+	ginMode        = flag.String("gin-mode", "debug", "debug for local runs, release to leave gin's route dump and warnings out of production logs")
+	tlsCert        = flag.String("tls-cert", "", "certificate file to serve https with, needs -tls-key too")
+	tlsKey         = flag.String("tls-key", "", "private key file for -tls-cert")
+	autocertDomain = flag.String("autocert-domains", "", "comma separated domains to get certificates for from let's encrypt")
+	autocertCache  = flag.String("autocert-cache", "", "directory to keep let's encrypt certificates in, under the user cache dir when empty")
+	autocertHTTP   = flag.String("autocert-http-addr", ":80", "where let's encrypt's http challenges are answered with autocert, which has to be reachable on port 80, anything else sent there is redirected to https")
+	storageBackend = flag.String("storage-backend", "disk", "where uploads are kept, disk or s3")
+	storageDir     = flag.String("storage", "", "directory to keep uploads in on disk, a fresh temp dir when empty")
+	s3Bucket       = flag.String("s3-bucket", "", "bucket to keep uploads in with the s3 backend")
+	s3Prefix       = flag.String("s3-prefix", "", "prepended to every id to make its object key with the s3 backend")
+	s3PathStyle    = flag.Bool("s3-path-style", false, "name the bucket in the url path rather than the host, which S3 compatible stores often need")
+	maxUpload      = flag.Int64("max-upload", 2<<30, "largest upload body accepted, in bytes")
+	authToken      = flag.String("auth-token", "", "bearer token every request must carry, auth is off when empty")
+	shareKey       = flag.String("share-key", "", "secret that links from POST /:id/share are signed with, sharing is off when empty")
+	anonymizeKey   = flag.String("anonymize-key", "", "secret POST /:id/anonymize remaps uids with, a random one lasting until restart when empty so copies made either side of it don't share uids")
+	allowedOrigins = flag.String("allowed-origins", "", "comma separated origins browsers may call from, or *, cors is off when empty")
+	trustedProxies = flag.String("trusted-proxies", "127.0.0.0/8,::1", "comma separated cidrs of proxies whose forwarded headers are believed for the client ip")
+	rateLimit      = flag.Float64("rate-limit", 0, "requests per second allowed from each client ip, unlimited when 0")
+	rateBurst      = flag.Int("rate-burst", 20, "requests a client ip can make in a burst above the rate limit")
+	cacheSize      = flag.Int("cache-size", 128, "parsed datasets to keep in memory, 0 turns the cache off")
+	frameCacheSize = flag.Int64("frame-cache-size", 256<<20, "bytes of decoded frames to keep in memory for rendering, 0 turns the cache off")
+	webhookURL     = flag.String("webhook-url", "", "url to post an event to whenever a file is stored or deleted, off when empty")
+	maxConcurrent  = flag.Int("max-requests", 0, "requests handled at once, further ones wait briefly then get a 503, unlimited when 0")
+	requestTimeout = flag.Duration("request-timeout", 10*time.Minute, "how long a single request may take before it's cancelled, 0 for no limit")
+	parseWorkers   = flag.Int("parse-workers", runtime.NumCPU(), "files parsed at once, further parses wait their turn")
+	parseQueue     = flag.Int("parse-queue", 64, "parses allowed to wait for a worker, past this they're turned away with a 503")
+	gzipStorage    = flag.Bool("compress-storage", false, "gzip files as they're stored, files are read back whether they're compressed or not")
+	windowPresets  = flag.String("window-presets", "", "json, or a file of it, with named windows for ?preset= under presets and default windows by modality under modalities")
+	imageMaxAge    = flag.Duration("image-max-age", time.Hour, "how long rendered images may be reused before checking back whether they've changed")
+	fileTTL        = flag.Duration("file-ttl", 0, "delete files this long after they were last written, 0 keeps them forever")
+	h2c            = flag.Bool("h2c", false, "speak http/2 over plain http to clients that start with it, as curl --http2-prior-knowledge does, tls gets http/2 either way")
+	idleTimeout    = flag.Duration("idle-timeout", 2*time.Minute, "how long a kept alive connection may sit unused before it's closed")
+	keepAlives     = flag.Bool("keep-alives", true, "reuse connections between requests, off closes each one after its response")
+	shutdownGrace  = flag.Duration("shutdown-grace", 30*time.Second, "how long in-flight requests get to finish on shutdown")
+)
+
+// environment variables backing each flag, for deployments where
+// setting the command line is a pain
+var flagEnv = map[string]string{
+	"addr":               "LISTEN_ADDR",
+	"gin-mode":           "GIN_MODE",
+	"tls-cert":           "TLS_CERT_FILE",
+	"tls-key":            "TLS_KEY_FILE",
+	"autocert-domains":   "AUTOCERT_DOMAINS",
+	"autocert-cache":     "AUTOCERT_CACHE_DIR",
+	"autocert-http-addr": "AUTOCERT_HTTP_ADDR",
+	"storage-backend":    "STORAGE_BACKEND",
+	"storage":            "STORAGE_DIR",
+	"s3-bucket":          "S3_BUCKET",
+	"s3-prefix":          "S3_PREFIX",
+	"s3-path-style":      "S3_PATH_STYLE",
+	"max-upload":         "MAX_UPLOAD_BYTES",
+	"auth-token":         "AUTH_TOKEN",
+	"share-key":          "SHARE_SIGNING_KEY",
+	"anonymize-key":      "ANONYMIZE_UID_KEY",
+	"allowed-origins":    "ALLOWED_ORIGINS",
+	"trusted-proxies":    "TRUSTED_PROXIES",
+	"rate-limit":         "RATE_LIMIT",
+	"rate-burst":         "RATE_BURST",
+	"cache-size":         "DATASET_CACHE_SIZE",
+	"frame-cache-size":   "FRAME_CACHE_BYTES",
+	"webhook-url":        "WEBHOOK_URL",
+	"max-requests":       "MAX_CONCURRENT_REQUESTS",
+	"request-timeout":    "REQUEST_TIMEOUT",
+	"parse-workers":      "PARSE_WORKERS",
+	"parse-queue":        "PARSE_QUEUE",
+	"compress-storage":   "COMPRESS_STORAGE",
+	"window-presets":     "WINDOW_PRESETS",
+	"image-max-age":      "IMAGE_MAX_AGE",
+	"file-ttl":           "FILE_TTL",
+	"h2c":                "H2C",
+	"idle-timeout":       "IDLE_TIMEOUT",
+	"keep-alives":        "KEEP_ALIVES",
+	"shutdown-grace":     "SHUTDOWN_GRACE",
+}
+
+// parseFlags applies the environment first and the command line on
+// top of it so an explicit flag always wins
+func parseFlags() error {
+	for name, key := range flagEnv {
+		f := flag.Lookup(name)
+		f.Usage += fmt.Sprintf(" (env %s)", key)
+
+		v := os.Getenv(key)
+		if v == "" {
+			continue
+		}
+		if err := f.Value.Set(v); err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+	flag.Parse()
+	return nil
+}
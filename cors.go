@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// request headers browsers are allowed to send and response headers
+// scripts are allowed to read, on top of the basic ones
+const (
+	corsAllowHeaders  = "Authorization, Content-Type, Range, If-None-Match, If-Match, X-Request-ID"
+	corsExposeHeaders = "Content-Disposition, Content-Range, ETag, Location, Retry-After, Server-Timing, X-Request-ID, X-Rows, X-Columns, X-Bits-Allocated, X-Samples-Per-Pixel"
+)
+
+// CORS lets browser pages from origins in the list call us, a * in
+// it allows any origin, preflights get answered here before they can
+// run into auth
+func CORS(origins []string) gin.HandlerFunc {
+	for i, o := range origins {
+		origins[i] = strings.TrimSpace(o)
+	}
+	anyOrigin := slices.Contains(origins, "*")
+	return func(ctx *gin.Context) {
+		origin := ctx.GetHeader("Origin")
+		if origin == "" || !anyOrigin && !slices.Contains(origins, origin) {
+			return
+		}
+
+		if anyOrigin {
+			ctx.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			ctx.Header("Access-Control-Allow-Origin", origin)
+			ctx.Writer.Header().Add("Vary", "Origin")
+		}
+		ctx.Header("Access-Control-Expose-Headers", corsExposeHeaders)
+
+		if ctx.Request.Method == http.MethodOptions && ctx.GetHeader("Access-Control-Request-Method") != "" {
+			ctx.Header("Access-Control-Allow-Methods", strings.Join([]string{
+				http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost, http.MethodPatch, http.MethodDelete,
+			}, ", "))
+			ctx.Header("Access-Control-Allow-Headers", corsAllowHeaders)
+			ctx.Header("Access-Control-Max-Age", "600")
+			ctx.AbortWithStatus(http.StatusNoContent)
+		}
+	}
+}
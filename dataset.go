@@ -0,0 +1,443 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/dicomio"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+// openFile opens the file stored under id, a missing one is a 404
+// rather than a failure on our end
+func openFile(ctx context.Context, storage fileStorage, id string) (*storedFile, error) {
+	file, err := openStored(ctx, storage, id)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, NewStatusError(http.StatusNotFound, err)
+	}
+	return file, err
+}
+
+// parseOptions go into every parse, the transfer syntax comes from
+// the file meta header but legacy files often leave out the group
+// length in front of it, which the parser otherwise insists on
+func parseOptions(opts ...dicom.ParseOption) []dicom.ParseOption {
+	return append([]dicom.ParseOption{dicom.AllowMissingMetaElementGroupLength()}, opts...)
+}
+
+// parseFile reads the whole dataset stored under id
+func parseFile(ctx context.Context, storage fileStorage, id string, opts ...dicom.ParseOption) (dcom dicom.Dataset, err error) {
+	file, err := openFile(ctx, storage, id)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	release, err := parsers.acquire(ctx)
+	if err != nil {
+		return
+	}
+	defer release()
+
+	dcom, err = dicom.ParseUntilEOF(file, nil, parseOptions(opts...)...)
+	if err != nil {
+		parseFailures.Inc()
+		err = pixelDataError(err)
+	}
+	return
+}
+
+// pixelDataError makes pixel data that doesn't add up a 422 rather
+// than a failure on our end, uploads are only checked without their
+// pixel data so it's the usual way a stored file turns out broken
+func pixelDataError(err error) error {
+	if errors.Is(err, dicom.ErrorMismatchPixelDataLength) || errors.Is(err, dicom.ErrorExpectedEvenLength) {
+		return NewStatusError(http.StatusUnprocessableEntity, fmt.Errorf("pixel data is truncated or corrupt: %w", err))
+	}
+	return err
+}
+
+// parseMeta reads just the file meta information header stored
+// under id, leaving the rest of the file unread
+func parseMeta(ctx context.Context, storage fileStorage, id string) (dicom.Dataset, error) {
+	file, err := openFile(ctx, storage, id)
+	if err != nil {
+		return dicom.Dataset{}, err
+	}
+	defer file.Close()
+	release, err := parsers.acquire(ctx)
+	if err != nil {
+		return dicom.Dataset{}, err
+	}
+	defer release()
+
+	br := bufio.NewReader(file)
+	if !hasDICOMMagic(br) {
+		parseFailures.Inc()
+		return dicom.Dataset{}, NewStatusError(http.StatusUnprocessableEntity, dicom.ErrorMagicWord)
+	}
+	// only the header gets read so there's no need to know how long
+	// the file is, which a compressed one can't say without reading
+	p, err := dicom.NewParser(br, dicomio.LimitReadUntilEOF, nil, parseOptions()...)
+	if err != nil {
+		parseFailures.Inc()
+		return dicom.Dataset{}, NewStatusError(http.StatusUnprocessableEntity, fmt.Errorf("invalid dicom header: %w", err))
+	}
+	return p.GetMetadata(), nil
+}
+
+// validateDICOM checks r for the DICM magic after the preamble and
+// then does a cheap parse of it, skipping the pixel data
+func validateDICOM(ctx context.Context, r io.Reader) (dicom.Dataset, error) {
+	// r is usually an upload still coming in, the slot's only held
+	// while there's some of it to parse
+	r, release, err := parsers.acquireReading(ctx, r)
+	if err != nil {
+		return dicom.Dataset{}, err
+	}
+	defer release()
+
+	br := bufio.NewReader(r)
+	if !hasDICOMMagic(br) {
+		parseFailures.Inc()
+		return dicom.Dataset{}, dicom.ErrorMagicWord
+	}
+
+	ds, err := dicom.ParseUntilEOF(br, nil, parseOptions(dicom.SkipPixelData())...)
+	if err != nil {
+		parseFailures.Inc()
+	}
+	return ds, err
+}
+
+// writeValidated copies an upload into w the way writeUpload does,
+// parsing it on the way rather than reading it back afterwards, a
+// body that turns out not to be dicom stops the copy there
+func writeValidated(ctx context.Context, w io.Writer, r io.Reader) (ds dicom.Dataset, err error) {
+	pr, pw := io.Pipe()
+	parsed := make(chan error, 1)
+	stopped := errors.New("upload stopped by a failed parse")
+	go func() {
+		var perr error
+		ds, perr = validateDICOM(ctx, pr)
+		if perr == nil {
+			// whatever's after the dataset still has to get written
+			io.Copy(io.Discard, pr)
+		}
+		// a failed parse fails the write it's holding up
+		if perr != nil {
+			pr.CloseWithError(stopped)
+		}
+		parsed <- perr
+	}()
+
+	err = writeUpload(w, io.TeeReader(r, pw))
+	// nil has the parser see the end of the file, anything else is
+	// the upload failing and the parse going down with it
+	pw.CloseWithError(err)
+	perr := <-parsed
+	if perr != nil && (err == nil || errors.Is(err, stopped)) {
+		if notTheUpload(perr) {
+			return dicom.Dataset{}, perr
+		}
+		return dicom.Dataset{}, NewStatusError(http.StatusBadRequest, fmt.Errorf("invalid dicom: %w", perr))
+	}
+	return
+}
+
+// notTheUpload is whether a parse of an upload failed because the
+// parse pool turned it away or the request went away, rather than
+// anything that's wrong with the upload itself
+func notTheUpload(err error) bool {
+	var serr *StatusError
+	return errors.As(err, &serr) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// hasDICOMMagic checks for the DICM magic after the 128 byte
+// preamble, peeking if r is buffered so nothing is consumed
+func hasDICOMMagic(r io.Reader) bool {
+	var preamble []byte
+	if br, ok := r.(*bufio.Reader); ok {
+		preamble, _ = br.Peek(132)
+	} else {
+		preamble = make([]byte, 132)
+		n, _ := io.ReadFull(r, preamble)
+		preamble = preamble[:n]
+	}
+	return len(preamble) == 132 && string(preamble[128:]) == "DICM"
+}
+
+// datasetString reads the first value of a string element, empty if
+// there isn't one
+func datasetString(ds dicom.Dataset, t tag.Tag) string {
+	elem, err := ds.FindElementByTag(t)
+	if err != nil {
+		return ""
+	}
+	vals, ok := elem.Value.GetValue().([]string)
+	if !ok || len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
+
+// uidName is the registry name of u, empty if it isn't a registered
+// uid
+func uidName(u string) string {
+	info, err := uid.Lookup(u)
+	if err != nil {
+		return ""
+	}
+	return info.Name
+}
+
+// lookupTag resolves a tag keyword in any case, or hex digits as
+// GGGGEEEE or GGGG,EEEE for tags that aren't in the dictionary
+func lookupTag(name string) (tag.Tag, error) {
+	name = strings.TrimSpace(name)
+	hex := strings.Trim(name, "()")
+	if group, elem, ok := strings.Cut(hex, ","); ok {
+		return hexTag(group, elem)
+	}
+	if len(hex) == 8 {
+		if t, err := hexTag(hex[:4], hex[4:]); err == nil {
+			return t, nil
+		}
+	}
+	info, err := findTagName(name)
+	if err != nil {
+		return tag.Tag{}, err
+	}
+	return info.Tag, nil
+}
+
+// findTagName looks a keyword up in the dictionary whatever its case,
+// suggesting the closest one when there's no such keyword
+func findTagName(name string) (tag.Info, error) {
+	name = strings.TrimSpace(name)
+	if info, err := tag.FindByName(name); err == nil {
+		return info, nil
+	}
+	if t, ok := dictionaryNames[strings.ToLower(name)]; ok {
+		return tag.Find(t)
+	}
+	if suggestion := closestTagName(name); suggestion != "" {
+		return tag.Info{}, fmt.Errorf("invalid tag name %q, did you mean %s?", name, suggestion)
+	}
+	return tag.Info{}, fmt.Errorf("invalid tag name %q", name)
+}
+
+// datasetInt reads the first value of an integer element
+func datasetInt(ds dicom.Dataset, t tag.Tag) (int, bool) {
+	elem, err := ds.FindElementByTag(t)
+	if err != nil {
+		return 0, false
+	}
+	vals, ok := elem.Value.GetValue().([]int)
+	if !ok || len(vals) == 0 {
+		return 0, false
+	}
+	return vals[0], true
+}
+
+// hexTag builds a tag straight from its hex group and element
+func hexTag(group, elem string) (tag.Tag, error) {
+	g, err := strconv.ParseUint(group, 16, 16)
+	if err != nil {
+		return tag.Tag{}, fmt.Errorf("invalid tag group %q: %w", group, err)
+	}
+	e, err := strconv.ParseUint(elem, 16, 16)
+	if err != nil {
+		return tag.Tag{}, fmt.Errorf("invalid tag element %q: %w", elem, err)
+	}
+	return tag.Tag{Group: uint16(g), Element: uint16(e)}, nil
+}
+
+// tagPath walks down through sequences, its tags alternate with the
+// index of the item to descend into
+type tagPath struct {
+	tags  []tag.Tag
+	items []int
+}
+
+// parseTagPath reads a dotted path like
+// ReferencedImageSequence.0.ReferencedSOPInstanceUID
+func parseTagPath(p string) (tp tagPath, err error) {
+	segs := strings.Split(p, ".")
+	if len(segs)%2 == 0 {
+		return tp, fmt.Errorf("path %q has to end in a tag", p)
+	}
+	for i, seg := range segs {
+		if i%2 == 1 {
+			n, err := strconv.Atoi(seg)
+			if err != nil || n < 0 {
+				return tp, fmt.Errorf("invalid item index %q in path %q", seg, p)
+			}
+			tp.items = append(tp.items, n)
+			continue
+		}
+		t, err := lookupTag(seg)
+		if err != nil {
+			return tp, err
+		}
+		tp.tags = append(tp.tags, t)
+	}
+	return
+}
+
+// find follows the path from the top level of elems
+func (tp tagPath) find(elems []*dicom.Element) (*dicom.Element, error) {
+	for i, t := range tp.tags {
+		j := slices.IndexFunc(elems, func(elem *dicom.Element) bool {
+			return elem.Tag == t
+		})
+		if j < 0 {
+			return nil, fmt.Errorf("%s: %w", t, dicom.ErrorElementNotFound)
+		}
+		if i == len(tp.items) {
+			return elems[j], nil
+		}
+
+		n := tp.items[i]
+		items, ok := elems[j].Value.GetValue().([]*dicom.SequenceItemValue)
+		if !ok || n >= len(items) {
+			return nil, fmt.Errorf("%s has no item %d: %w", t, n, dicom.ErrorElementNotFound)
+		}
+		elems = items[n].GetValue().([]*dicom.Element)
+	}
+	return nil, dicom.ErrorElementNotFound
+}
+
+// setElement gives t a new value in ds, slotting it in by tag order if
+// it isn't there already
+func setElement(ds *dicom.Dataset, t tag.Tag, data any) (*dicom.Element, error) {
+	elem, err := dicom.NewElement(t, data)
+	if err != nil {
+		return nil, err
+	}
+
+	i, found := slices.BinarySearchFunc(ds.Elements, t, func(e *dicom.Element, t tag.Tag) int {
+		return e.Tag.Compare(t)
+	})
+	if found {
+		ds.Elements[i] = elem
+	} else {
+		ds.Elements = slices.Insert(ds.Elements, i, elem)
+	}
+	return elem, nil
+}
+
+// writeDataset serializes ds, trusting the VRs it was parsed with
+// rather than second guessing them against the dictionary, the writer
+// puts native pixel data out little endian whatever the syntax says
+// so big endian files are written back as explicit vr little endian
+// rather than with their pixels byte swapped
+func writeDataset(w io.Writer, ds dicom.Dataset) error {
+	if datasetString(ds, tag.TransferSyntaxUID) == uid.ExplicitVRBigEndian {
+		ds.Elements = slices.Clone(ds.Elements)
+		if _, err := setElement(&ds, tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}); err != nil {
+			return err
+		}
+	}
+	return dicom.Write(w, ds, dicom.SkipVRVerification())
+}
+
+// tagValue gives elem's value in the shape the tag endpoints promise,
+// every string, number and tag VR is a list with one entry per value
+// even when there's only the one, an element with no value is an
+// empty list, strings lose their padding, sequences are a list of
+// items each shaped the same way and bytes stay as base64
+func tagValue(elem *dicom.Element) any {
+	return shapeValue(elem, -1)
+}
+
+// shapeValue is tagValue going depth levels into sequence items, or
+// all of them when depth is negative
+func shapeValue(elem *dicom.Element, depth int) any {
+	var shaped any
+	switch v := elem.Value.GetValue().(type) {
+	case []string:
+		vals := make([]string, len(v))
+		for i, s := range v {
+			vals[i] = strings.TrimRight(s, " \x00")
+			// text VRs are the only ones where leading spaces mean
+			// something
+			if elem.RawValueRepresentation != "LT" && elem.RawValueRepresentation != "ST" && elem.RawValueRepresentation != "UT" {
+				vals[i] = strings.TrimLeft(vals[i], " ")
+			}
+		}
+		if len(vals) == 1 && vals[0] == "" {
+			vals = []string{}
+		}
+		shaped = vals
+	case []int:
+		if v == nil {
+			shaped = []int{}
+		}
+	case []float64:
+		if v == nil {
+			shaped = []float64{}
+		}
+	case []*dicom.SequenceItemValue:
+		items := make([][]*shapedElement, len(v))
+		for i, item := range v {
+			elems, _ := item.GetValue().([]*dicom.Element)
+			items[i] = make([]*shapedElement, len(elems))
+			for j, e := range elems {
+				items[i][j] = tagElement(e, depth-1)
+			}
+		}
+		return items
+	}
+	if shaped == nil {
+		return elem.Value
+	}
+	v, _ := dicom.NewValue(shaped)
+	return v
+}
+
+// shapedElement is an element the way the tag endpoints send it, a
+// sequence past the depth asked for has a null value and just says
+// how many items it had, hex and vr spell out the tag and its VR so
+// clients don't have to decode the parser's own fields
+type shapedElement struct {
+	*dicom.Element
+	Hex   string `json:"hex"`
+	VR    string `json:"vr"`
+	Value any    `json:"value"`
+	Items *int   `json:"items,omitempty"`
+}
+
+// tagElement is elem with its value shaped by tagValue, going depth
+// levels into sequence items or all of them when depth is negative
+func tagElement(elem *dicom.Element, depth int) *shapedElement {
+	shaped := &shapedElement{Element: elem, Hex: jsonKey(elem.Tag), VR: elementVR(elem)}
+	if items, ok := elem.Value.GetValue().([]*dicom.SequenceItemValue); ok && depth == 0 {
+		n := len(items)
+		shaped.Items = &n
+		return shaped
+	}
+	shaped.Value = shapeValue(elem, depth)
+	return shaped
+}
+
+// elementVR is the VR elem was read with, falling back on the
+// dictionary for elements built without one
+func elementVR(elem *dicom.Element) string {
+	if elem.RawValueRepresentation != "" {
+		return elem.RawValueRepresentation
+	}
+	if info, err := tag.Find(elem.Tag); err == nil {
+		return info.VR
+	}
+	return "UN"
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// RetrieveURL is missing from the tag dictionary
+var retrieveURLTag = tag.Tag{Group: 0x0008, Element: 0x1190}
+
+// failure reasons from PS3.4 Annex GG.4
+const (
+	failureProcessing       = 0x0110
+	failureCannotUnderstand = 0xC000
+)
+
+// jsonAttr is a single attribute of the PS3.18 Annex F json model
+type jsonAttr struct {
+	VR           string `json:"vr"`
+	Value        []any  `json:"Value,omitempty"`
+	InlineBinary []byte `json:"InlineBinary,omitempty"`
+}
+
+// jsonDataset is a dataset in the PS3.18 Annex F json model, keyed
+// by the tag as 8 hex digits
+type jsonDataset map[string]jsonAttr
+
+func (d jsonDataset) set(t tag.Tag, vr string, values ...any) {
+	d[jsonKey(t)] = jsonAttr{VR: vr, Value: values}
+}
+
+func jsonKey(t tag.Tag) string {
+	return fmt.Sprintf("%04X%04X", t.Group, t.Element)
+}
+
+// toJSONAttr converts an element into the Annex F json model, numeric
+// strings become numbers and person names get their Alphabetic form
+func toJSONAttr(elem *dicom.Element) jsonAttr {
+	attr := jsonAttr{VR: elem.RawValueRepresentation}
+	switch vals := elem.Value.GetValue().(type) {
+	case []string:
+		for _, v := range vals {
+			attr.Value = append(attr.Value, jsonString(attr.VR, v))
+		}
+	case []int:
+		for _, v := range vals {
+			attr.Value = append(attr.Value, v)
+		}
+	case []float64:
+		for _, v := range vals {
+			attr.Value = append(attr.Value, v)
+		}
+	case []byte:
+		attr.InlineBinary = vals
+	case []*dicom.SequenceItemValue:
+		for _, item := range vals {
+			attr.Value = append(attr.Value, toJSONDataset(item.GetValue().([]*dicom.Element)))
+		}
+	}
+	return attr
+}
+
+func jsonString(vr, v string) any {
+	if v == "" {
+		return nil
+	}
+	switch vr {
+	case "PN":
+		return map[string]string{"Alphabetic": v}
+	case "DS", "IS":
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err == nil {
+			return f
+		}
+	}
+	return v
+}
+
+func toJSONDataset(elems []*dicom.Element) jsonDataset {
+	d := jsonDataset{}
+	for _, elem := range elems {
+		d[jsonKey(elem.Tag)] = toJSONAttr(elem)
+	}
+	return d
+}
+
+// formats metadata can go out in, the library's own shape or the
+// PS3.18 Annex F json and PS3.19 native xml models
+const (
+	formatJSON      = "application/json"
+	formatDICOMJSON = "application/dicom+json"
+	formatDICOMXML  = "application/dicom+xml"
+)
+
+// metadataFormat is which of those metadata should go out in, from
+// ?format= or failing that the Accept header, a + left unescaped in the
+// query comes through as a space so dicom json counts as dicom+json too
+func metadataFormat(ctx *gin.Context) (string, error) {
+	format, ok := ctx.GetQuery("format")
+	if !ok {
+		switch negotiated := ctx.NegotiateFormat(formatJSON, formatDICOMJSON, formatDICOMXML); negotiated {
+		case formatDICOMJSON, formatDICOMXML:
+			return negotiated, nil
+		}
+		return formatJSON, nil
+	}
+	switch format {
+	case "json", formatJSON:
+		return formatJSON, nil
+	case "dicom+json", "dicom json", formatDICOMJSON:
+		return formatDICOMJSON, nil
+	case "dicom+xml", "dicom xml", formatDICOMXML:
+		return formatDICOMXML, nil
+	}
+	return "", NewStatusError(http.StatusNotAcceptable, fmt.Errorf("unsupported metadata format %q", format))
+}
+
+// writeModel sends elems in one of the dicom models, format being
+// dicom+json or dicom+xml
+func writeModel(ctx *gin.Context, format string, elems []*dicom.Element) error {
+	ctx.Header("Content-Type", format)
+	if format == formatDICOMXML {
+		ctx.Status(http.StatusOK)
+		return writeXMLDataset(ctx.Writer, elems)
+	}
+	ctx.JSON(http.StatusOK, toJSONDataset(elems))
+	return nil
+}
+
+// baseURL is where the client reached us, for building links back
+func baseURL(ctx *gin.Context) string {
+	scheme := "http"
+	if ctx.Request.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + ctx.Request.Host
+}
+
+// instanceURL is the WADO-RS location of a stored instance
+func instanceURL(ctx *gin.Context, ds dicom.Dataset) string {
+	return fmt.Sprintf("%s/studies/%s/series/%s/instances/%s", baseURL(ctx),
+		datasetString(ds, tag.StudyInstanceUID),
+		datasetString(ds, tag.SeriesInstanceUID),
+		datasetString(ds, tag.SOPInstanceUID))
+}
+
+// dicomParts opens a STOW-RS multipart/related request body
+func dicomParts(req *http.Request) (*multipart.Reader, error) {
+	mediatype, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, NewStatusError(http.StatusUnsupportedMediaType, err)
+	}
+	if mediatype != "multipart/related" || params["boundary"] == "" {
+		return nil, NewStatusError(http.StatusUnsupportedMediaType, errors.New("expected a multipart/related body"))
+	}
+	if t := params["type"]; t != "" && t != "application/dicom" {
+		return nil, NewStatusError(http.StatusUnsupportedMediaType, fmt.Errorf("unsupported part type %q", t))
+	}
+	return multipart.NewReader(req.Body, params["boundary"]), nil
+}
+
+// storeInstance saves an instance under id, or when that's empty its
+// SOPInstanceUID, which isn't known until it's been written so it
+// lands in a scratch file that's renamed into place, it returns still
+// holding the id's lock so the caller can clear out what's cached for
+// it before anyone reads the new file, then unlock
+func storeInstance(ctx context.Context, storage fileStorage, locks *idLocks, r io.Reader, id string) (ds dicom.Dataset, unlock func(), err error) {
+	name := id
+	if name == "" {
+		name = scratchName()
+	}
+	file, err := stage(ctx, storage, name)
+	if err != nil {
+		return
+	}
+	defer file.Abort()
+	ds, err = writeValidated(ctx, file, r)
+	if err != nil {
+		return
+	}
+
+	bySOP := id == ""
+	if bySOP {
+		id = datasetString(ds, tag.SOPInstanceUID)
+		if id == "" {
+			err = NewStatusError(http.StatusBadRequest, errors.New("instance has no SOPInstanceUID"))
+			return
+		}
+		if err = validID(id); err != nil {
+			err = NewStatusError(http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	unlock = locks.lock(id)
+	defer func() {
+		if err != nil {
+			unlock()
+			unlock = nil
+		}
+	}()
+	err = file.Commit()
+	if err != nil || !bySOP {
+		return
+	}
+	err = storage.Rename(ctx, name, id)
+	if err != nil {
+		storage.Remove(context.WithoutCancel(ctx), name)
+	}
+	return
+}
+
+// stowResponse builds the PS3.18 10.5.3 store instances response
+// along with the status it should be sent with
+func stowResponse(ctx *gin.Context, stored []dicom.Dataset, failed []error) (int, jsonDataset) {
+	resp := jsonDataset{}
+
+	var refs []any
+	for _, ds := range stored {
+		ref := jsonDataset{}
+		ref.set(tag.ReferencedSOPClassUID, "UI", datasetString(ds, tag.SOPClassUID))
+		ref.set(tag.ReferencedSOPInstanceUID, "UI", datasetString(ds, tag.SOPInstanceUID))
+		ref.set(retrieveURLTag, "UR", instanceURL(ctx, ds))
+		refs = append(refs, ref)
+	}
+	if len(refs) > 0 {
+		resp.set(tag.ReferencedSOPSequence, "SQ", refs...)
+	}
+
+	var fails []any
+	for _, err := range failed {
+		reason := failureProcessing
+		var serr *StatusError
+		if errors.As(err, &serr) && serr.Code == http.StatusBadRequest {
+			reason = failureCannotUnderstand
+		}
+		fail := jsonDataset{}
+		fail.set(tag.FailureReason, "US", reason)
+		fails = append(fails, fail)
+	}
+	if len(fails) > 0 {
+		resp.set(tag.FailedSOPSequence, "SQ", fails...)
+	}
+
+	switch {
+	case len(failed) == 0:
+		return http.StatusOK, resp
+	case len(stored) == 0:
+		return http.StatusConflict, resp
+	default:
+		return http.StatusAccepted, resp
+	}
+}
+
+// study level attributes QIDO-RS returns by default, PS3.18 10.6.3.3.1
+var studyFields = []tag.Tag{
+	tag.StudyDate,
+	tag.StudyTime,
+	tag.AccessionNumber,
+	tag.ReferringPhysicianName,
+	tag.PatientName,
+	tag.PatientID,
+	tag.PatientBirthDate,
+	tag.PatientSex,
+	tag.StudyInstanceUID,
+	tag.StudyID,
+}
+
+// qidoParams are the query params that control a search rather than
+// match against attributes
+var qidoParams = []string{"includefield", "limit", "offset", "fuzzymatching"}
+
+// studyQuery is a parsed QIDO-RS search
+type studyQuery struct {
+	match  map[tag.Tag]string
+	fields []tag.Tag
+	all    bool
+}
+
+// parseStudyQuery splits the query string into attribute matches and
+// the fields to return, attributes can be keywords or hex tags
+func parseStudyQuery(ctx *gin.Context) (q studyQuery, err error) {
+	q.match = map[tag.Tag]string{}
+	q.fields = slices.Clone(studyFields)
+	for key, vals := range ctx.Request.URL.Query() {
+		if slices.Contains(qidoParams, key) {
+			continue
+		}
+		t, err := lookupTag(key)
+		if err != nil {
+			return q, NewStatusError(http.StatusBadRequest, err)
+		}
+		q.match[t] = vals[0]
+		q.fields = append(q.fields, t)
+	}
+
+	for _, field := range ctx.QueryArray("includefield") {
+		for _, name := range strings.Split(field, ",") {
+			if name == "all" {
+				q.all = true
+				continue
+			}
+			t, err := lookupTag(name)
+			if err != nil {
+				return q, NewStatusError(http.StatusBadRequest, err)
+			}
+			q.fields = append(q.fields, t)
+		}
+	}
+	return
+}
+
+// matches checks every attribute in the query equals one of the
+// values in ds, person names compare case insensitively
+func (q studyQuery) matches(ds dicom.Dataset) bool {
+	for t, want := range q.match {
+		elem, err := ds.FindElementByTag(t)
+		if err != nil {
+			return false
+		}
+
+		var vals []string
+		switch v := elem.Value.GetValue().(type) {
+		case []string:
+			vals = v
+		case []int, []float64:
+			vals = strings.Fields(strings.Trim(fmt.Sprint(v), "[]"))
+		}
+		if !slices.ContainsFunc(vals, func(v string) bool {
+			v = strings.TrimSpace(v)
+			if elem.RawValueRepresentation == "PN" {
+				return strings.EqualFold(v, want)
+			}
+			return v == want
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// result picks the requested fields out of ds
+func (q studyQuery) result(ds dicom.Dataset) jsonDataset {
+	d := jsonDataset{}
+	for _, elem := range ds.Elements {
+		if elem.Tag.Group == tag.MetadataGroup || elem.Tag == tag.PixelData {
+			continue
+		}
+		if q.all || slices.Contains(q.fields, elem.Tag) {
+			d[jsonKey(elem.Tag)] = toJSONAttr(elem)
+		}
+	}
+	return d
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// xmlDataset is a dataset in the PS3.19 Native DICOM Model, which is
+// what application/dicom+xml carries
+type xmlDataset struct {
+	XMLName    xml.Name       `xml:"http://dicom.nema.org/PS3.19/models/NativeDICOM NativeDicomModel"`
+	Space      string         `xml:"http://www.w3.org/XML/1998/namespace space,attr"`
+	Attributes []xmlAttribute `xml:"DicomAttribute"`
+}
+
+type xmlAttribute struct {
+	Tag          string          `xml:"tag,attr"`
+	VR           string          `xml:"vr,attr"`
+	Keyword      string          `xml:"keyword,attr,omitempty"`
+	Values       []xmlValue      `xml:"Value"`
+	PersonNames  []xmlPersonName `xml:"PersonName"`
+	InlineBinary string          `xml:"InlineBinary,omitempty"`
+	Items        []xmlItem       `xml:"Item"`
+}
+
+type xmlValue struct {
+	Number int    `xml:"number,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type xmlItem struct {
+	Number     int            `xml:"number,attr"`
+	Attributes []xmlAttribute `xml:"DicomAttribute"`
+}
+
+type xmlPersonName struct {
+	Number      int          `xml:"number,attr"`
+	Alphabetic  *xmlNameForm `xml:"Alphabetic"`
+	Ideographic *xmlNameForm `xml:"Ideographic"`
+	Phonetic    *xmlNameForm `xml:"Phonetic"`
+}
+
+// xmlNameForm is one of a person name's component groups, split up
+// into the five components the name's carets separate
+type xmlNameForm struct {
+	FamilyName string `xml:",omitempty"`
+	GivenName  string `xml:",omitempty"`
+	MiddleName string `xml:",omitempty"`
+	NamePrefix string `xml:",omitempty"`
+	NameSuffix string `xml:",omitempty"`
+}
+
+func toXMLDataset(elems []*dicom.Element) xmlDataset {
+	return xmlDataset{Space: "preserve", Attributes: toXMLAttributes(elems)}
+}
+
+func toXMLAttributes(elems []*dicom.Element) []xmlAttribute {
+	attrs := make([]xmlAttribute, 0, len(elems))
+	for _, elem := range elems {
+		attrs = append(attrs, toXMLAttribute(elem))
+	}
+	return attrs
+}
+
+// toXMLAttribute converts an element into the Native DICOM Model,
+// values are numbered from 1 and bulk data goes inline as base64
+func toXMLAttribute(elem *dicom.Element) xmlAttribute {
+	attr := xmlAttribute{Tag: jsonKey(elem.Tag), VR: elementVR(elem)}
+	if info, err := tag.Find(elem.Tag); err == nil {
+		attr.Keyword = info.Name
+	}
+	switch vals := elem.Value.GetValue().(type) {
+	case []string:
+		for i, v := range vals {
+			if attr.VR == "PN" {
+				attr.PersonNames = append(attr.PersonNames, xmlName(i+1, v))
+				continue
+			}
+			attr.Values = append(attr.Values, xmlValue{i + 1, strings.TrimSpace(v)})
+		}
+	case []int:
+		for i, v := range vals {
+			attr.Values = append(attr.Values, xmlValue{i + 1, strconv.Itoa(v)})
+		}
+	case []float64:
+		for i, v := range vals {
+			attr.Values = append(attr.Values, xmlValue{i + 1, strconv.FormatFloat(v, 'g', -1, 64)})
+		}
+	case []byte:
+		attr.InlineBinary = base64.StdEncoding.EncodeToString(vals)
+	case []*dicom.SequenceItemValue:
+		for i, item := range vals {
+			attr.Items = append(attr.Items, xmlItem{i + 1, toXMLAttributes(item.GetValue().([]*dicom.Element))})
+		}
+	}
+	return attr
+}
+
+// xmlName splits a person name into its alphabetic, ideographic and
+// phonetic groups, leaving out any that are empty
+func xmlName(n int, v string) xmlPersonName {
+	name := xmlPersonName{Number: n}
+	forms := []**xmlNameForm{&name.Alphabetic, &name.Ideographic, &name.Phonetic}
+	for i, group := range strings.SplitN(strings.TrimSpace(v), "=", len(forms)) {
+		if group == "" {
+			continue
+		}
+		var parts [5]string
+		for j, part := range strings.SplitN(group, "^", len(parts)) {
+			parts[j] = part
+		}
+		*forms[i] = &xmlNameForm{parts[0], parts[1], parts[2], parts[3], parts[4]}
+	}
+	return name
+}
+
+// writeXMLDataset encodes elems as a whole Native DICOM Model document
+func writeXMLDataset(w io.Writer, elems []*dicom.Element) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	return xml.NewEncoder(w).Encode(toXMLDataset(elems))
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// the tag package keeps its dictionary to itself, so the tags in it
+// are pulled out of its source and looked up again from there
+//go:generate sh -c "{ echo '// Code generated by go generate; DO NOT EDIT.'; echo; echo 'package main'; echo; echo 'import \"github.com/suyashkumar/dicom/pkg/tag\"'; echo; echo 'var dictionaryTags = []tag.Tag{'; sed -n 's/^\\ttagDict\\[Tag{\\(0x[0-9A-Fa-f]*\\), \\(0x[0-9A-Fa-f]*\\)}\\].*/{Group: \\1, Element: \\2},/p' \"$(go list -m -f '{{.Dir}}' github.com/suyashkumar/dicom)/pkg/tag/tag_definitions.go\"; echo '}'; } > dictionary_tags.go && gofmt -w dictionary_tags.go"
+
+// dictionaryEntry is a tag the dictionary knows, with its group and
+// element in hex the way the tag endpoints take them
+type dictionaryEntry struct {
+	Name    string `json:"name"`
+	Group   string `json:"group"`
+	Element string `json:"element"`
+	VR      string `json:"vr"`
+	VM      string `json:"vm"`
+}
+
+// dictionary is every entry sorted by tag
+var dictionary = func() []dictionaryEntry {
+	entries := make([]dictionaryEntry, 0, len(dictionaryTags))
+	for _, t := range slices.SortedFunc(slices.Values(dictionaryTags), tag.Tag.Compare) {
+		info, err := tag.Find(t)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, dictionaryEntry{
+			Name:    info.Name,
+			Group:   fmt.Sprintf("%04X", t.Group),
+			Element: fmt.Sprintf("%04X", t.Element),
+			VR:      info.VR,
+			VM:      info.VM,
+		})
+	}
+	return entries
+}()
+
+// searchDictionary finds entries by a case insensitive substring of
+// their name, an empty filter matches everything
+func searchDictionary(filter string) []dictionaryEntry {
+	if filter == "" {
+		return dictionary
+	}
+	filter = strings.ToLower(filter)
+	matches := []dictionaryEntry{}
+	for _, e := range dictionary {
+		if strings.Contains(strings.ToLower(e.Name), filter) {
+			matches = append(matches, e)
+		}
+	}
+	return matches
+}
+
+// dictionaryNames has every entry under its lowercased name, for
+// keywords typed without minding their case
+var dictionaryNames = func() map[string]tag.Tag {
+	names := make(map[string]tag.Tag, len(dictionary))
+	for _, t := range dictionaryTags {
+		if info, err := tag.Find(t); err == nil {
+			names[strings.ToLower(info.Name)] = t
+		}
+	}
+	return names
+}()
+
+// closestTagName suggests the dictionary name that name was most
+// likely meant to be, the shortest one it's the start of or else the
+// fewest edits away, nothing if even that's more than half of it
+func closestTagName(name string) string {
+	name = strings.ToLower(name)
+	if name == "" {
+		return ""
+	}
+	best, dist := "", len(name)/2+1
+	for _, e := range dictionary {
+		lower := strings.ToLower(e.Name)
+		if strings.HasPrefix(lower, name) && (dist > 0 || len(e.Name) < len(best)) {
+			best, dist = e.Name, 0
+			continue
+		}
+		if dist == 0 {
+			continue
+		}
+		if d := editDistance(name, lower); d < dist {
+			best, dist = e.Name, d
+		}
+	}
+	return best
+}
+
+// editDistance is the levenshtein distance between a and b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,3738 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package main
+
+import "github.com/suyashkumar/dicom/pkg/tag"
+
+var dictionaryTags = []tag.Tag{
+	{Group: 0x0000, Element: 0x0000},
+	{Group: 0x0000, Element: 0x0002},
+	{Group: 0x0000, Element: 0x0003},
+	{Group: 0x0000, Element: 0x0100},
+	{Group: 0x0000, Element: 0x0110},
+	{Group: 0x0000, Element: 0x0120},
+	{Group: 0x0000, Element: 0x0600},
+	{Group: 0x0000, Element: 0x0700},
+	{Group: 0x0000, Element: 0x0800},
+	{Group: 0x0000, Element: 0x0900},
+	{Group: 0x0000, Element: 0x0901},
+	{Group: 0x0000, Element: 0x0902},
+	{Group: 0x0000, Element: 0x0903},
+	{Group: 0x0000, Element: 0x1000},
+	{Group: 0x0000, Element: 0x1001},
+	{Group: 0x0000, Element: 0x1002},
+	{Group: 0x0000, Element: 0x1005},
+	{Group: 0x0000, Element: 0x1008},
+	{Group: 0x0000, Element: 0x1020},
+	{Group: 0x0000, Element: 0x1021},
+	{Group: 0x0000, Element: 0x1022},
+	{Group: 0x0000, Element: 0x1023},
+	{Group: 0x0000, Element: 0x1030},
+	{Group: 0x0000, Element: 0x1031},
+	{Group: 0x0002, Element: 0x0000},
+	{Group: 0x0002, Element: 0x0001},
+	{Group: 0x0002, Element: 0x0002},
+	{Group: 0x0002, Element: 0x0003},
+	{Group: 0x0002, Element: 0x0010},
+	{Group: 0x0002, Element: 0x0012},
+	{Group: 0x0002, Element: 0x0013},
+	{Group: 0x0002, Element: 0x0016},
+	{Group: 0x0002, Element: 0x0100},
+	{Group: 0x0002, Element: 0x0102},
+	{Group: 0x0004, Element: 0x1130},
+	{Group: 0x0004, Element: 0x1141},
+	{Group: 0x0004, Element: 0x1142},
+	{Group: 0x0004, Element: 0x1200},
+	{Group: 0x0004, Element: 0x1202},
+	{Group: 0x0004, Element: 0x1212},
+	{Group: 0x0004, Element: 0x1220},
+	{Group: 0x0004, Element: 0x1400},
+	{Group: 0x0004, Element: 0x1410},
+	{Group: 0x0004, Element: 0x1420},
+	{Group: 0x0004, Element: 0x1430},
+	{Group: 0x0004, Element: 0x1432},
+	{Group: 0x0004, Element: 0x1500},
+	{Group: 0x0004, Element: 0x1510},
+	{Group: 0x0004, Element: 0x1511},
+	{Group: 0x0004, Element: 0x1512},
+	{Group: 0x0004, Element: 0x151A},
+	{Group: 0x0008, Element: 0x0005},
+	{Group: 0x0008, Element: 0x0006},
+	{Group: 0x0008, Element: 0x0008},
+	{Group: 0x0008, Element: 0x0012},
+	{Group: 0x0008, Element: 0x0013},
+	{Group: 0x0008, Element: 0x0014},
+	{Group: 0x0008, Element: 0x0016},
+	{Group: 0x0008, Element: 0x0018},
+	{Group: 0x0008, Element: 0x001A},
+	{Group: 0x0008, Element: 0x001B},
+	{Group: 0x0008, Element: 0x0020},
+	{Group: 0x0008, Element: 0x0021},
+	{Group: 0x0008, Element: 0x0022},
+	{Group: 0x0008, Element: 0x0023},
+	{Group: 0x0008, Element: 0x002A},
+	{Group: 0x0008, Element: 0x0030},
+	{Group: 0x0008, Element: 0x0031},
+	{Group: 0x0008, Element: 0x0032},
+	{Group: 0x0008, Element: 0x0033},
+	{Group: 0x0008, Element: 0x0050},
+	{Group: 0x0008, Element: 0x0051},
+	{Group: 0x0008, Element: 0x0052},
+	{Group: 0x0008, Element: 0x0054},
+	{Group: 0x0008, Element: 0x0056},
+	{Group: 0x0008, Element: 0x0058},
+	{Group: 0x0008, Element: 0x0060},
+	{Group: 0x0008, Element: 0x0061},
+	{Group: 0x0008, Element: 0x0062},
+	{Group: 0x0008, Element: 0x0064},
+	{Group: 0x0008, Element: 0x0068},
+	{Group: 0x0008, Element: 0x0070},
+	{Group: 0x0008, Element: 0x0080},
+	{Group: 0x0008, Element: 0x0081},
+	{Group: 0x0008, Element: 0x0082},
+	{Group: 0x0008, Element: 0x0090},
+	{Group: 0x0008, Element: 0x0092},
+	{Group: 0x0008, Element: 0x0094},
+	{Group: 0x0008, Element: 0x0096},
+	{Group: 0x0008, Element: 0x0100},
+	{Group: 0x0008, Element: 0x0102},
+	{Group: 0x0008, Element: 0x0103},
+	{Group: 0x0008, Element: 0x0104},
+	{Group: 0x0008, Element: 0x0105},
+	{Group: 0x0008, Element: 0x0106},
+	{Group: 0x0008, Element: 0x0107},
+	{Group: 0x0008, Element: 0x010B},
+	{Group: 0x0008, Element: 0x010C},
+	{Group: 0x0008, Element: 0x010D},
+	{Group: 0x0008, Element: 0x010F},
+	{Group: 0x0008, Element: 0x0110},
+	{Group: 0x0008, Element: 0x0112},
+	{Group: 0x0008, Element: 0x0114},
+	{Group: 0x0008, Element: 0x0115},
+	{Group: 0x0008, Element: 0x0116},
+	{Group: 0x0008, Element: 0x0117},
+	{Group: 0x0008, Element: 0x0201},
+	{Group: 0x0008, Element: 0x1010},
+	{Group: 0x0008, Element: 0x1030},
+	{Group: 0x0008, Element: 0x1032},
+	{Group: 0x0008, Element: 0x103E},
+	{Group: 0x0008, Element: 0x103F},
+	{Group: 0x0008, Element: 0x1040},
+	{Group: 0x0008, Element: 0x1048},
+	{Group: 0x0008, Element: 0x1049},
+	{Group: 0x0008, Element: 0x1050},
+	{Group: 0x0008, Element: 0x1052},
+	{Group: 0x0008, Element: 0x1060},
+	{Group: 0x0008, Element: 0x1062},
+	{Group: 0x0008, Element: 0x1070},
+	{Group: 0x0008, Element: 0x1072},
+	{Group: 0x0008, Element: 0x1080},
+	{Group: 0x0008, Element: 0x1084},
+	{Group: 0x0008, Element: 0x1090},
+	{Group: 0x0008, Element: 0x1110},
+	{Group: 0x0008, Element: 0x1111},
+	{Group: 0x0008, Element: 0x1115},
+	{Group: 0x0008, Element: 0x1120},
+	{Group: 0x0008, Element: 0x1125},
+	{Group: 0x0008, Element: 0x1134},
+	{Group: 0x0008, Element: 0x113A},
+	{Group: 0x0008, Element: 0x1140},
+	{Group: 0x0008, Element: 0x114A},
+	{Group: 0x0008, Element: 0x114B},
+	{Group: 0x0008, Element: 0x1150},
+	{Group: 0x0008, Element: 0x1155},
+	{Group: 0x0008, Element: 0x115A},
+	{Group: 0x0008, Element: 0x1160},
+	{Group: 0x0008, Element: 0x1161},
+	{Group: 0x0008, Element: 0x1162},
+	{Group: 0x0008, Element: 0x1163},
+	{Group: 0x0008, Element: 0x1164},
+	{Group: 0x0008, Element: 0x1167},
+	{Group: 0x0008, Element: 0x1195},
+	{Group: 0x0008, Element: 0x1197},
+	{Group: 0x0008, Element: 0x1198},
+	{Group: 0x0008, Element: 0x1199},
+	{Group: 0x0008, Element: 0x1200},
+	{Group: 0x0008, Element: 0x1250},
+	{Group: 0x0008, Element: 0x2111},
+	{Group: 0x0008, Element: 0x2112},
+	{Group: 0x0008, Element: 0x2120},
+	{Group: 0x0008, Element: 0x2122},
+	{Group: 0x0008, Element: 0x2124},
+	{Group: 0x0008, Element: 0x2127},
+	{Group: 0x0008, Element: 0x2128},
+	{Group: 0x0008, Element: 0x2129},
+	{Group: 0x0008, Element: 0x212A},
+	{Group: 0x0008, Element: 0x2130},
+	{Group: 0x0008, Element: 0x2132},
+	{Group: 0x0008, Element: 0x2133},
+	{Group: 0x0008, Element: 0x2134},
+	{Group: 0x0008, Element: 0x2135},
+	{Group: 0x0008, Element: 0x2142},
+	{Group: 0x0008, Element: 0x2143},
+	{Group: 0x0008, Element: 0x2144},
+	{Group: 0x0008, Element: 0x2218},
+	{Group: 0x0008, Element: 0x2220},
+	{Group: 0x0008, Element: 0x2228},
+	{Group: 0x0008, Element: 0x2229},
+	{Group: 0x0008, Element: 0x2230},
+	{Group: 0x0008, Element: 0x3001},
+	{Group: 0x0008, Element: 0x3010},
+	{Group: 0x0008, Element: 0x9007},
+	{Group: 0x0008, Element: 0x9092},
+	{Group: 0x0008, Element: 0x9121},
+	{Group: 0x0008, Element: 0x9123},
+	{Group: 0x0008, Element: 0x9124},
+	{Group: 0x0008, Element: 0x9154},
+	{Group: 0x0008, Element: 0x9205},
+	{Group: 0x0008, Element: 0x9206},
+	{Group: 0x0008, Element: 0x9207},
+	{Group: 0x0008, Element: 0x9208},
+	{Group: 0x0008, Element: 0x9209},
+	{Group: 0x0008, Element: 0x9215},
+	{Group: 0x0008, Element: 0x9237},
+	{Group: 0x0008, Element: 0x9410},
+	{Group: 0x0008, Element: 0x9458},
+	{Group: 0x0008, Element: 0x9459},
+	{Group: 0x0008, Element: 0x9460},
+	{Group: 0x0010, Element: 0x0010},
+	{Group: 0x0010, Element: 0x0020},
+	{Group: 0x0010, Element: 0x0021},
+	{Group: 0x0010, Element: 0x0022},
+	{Group: 0x0010, Element: 0x0024},
+	{Group: 0x0010, Element: 0x0030},
+	{Group: 0x0010, Element: 0x0032},
+	{Group: 0x0010, Element: 0x0040},
+	{Group: 0x0010, Element: 0x0050},
+	{Group: 0x0010, Element: 0x0101},
+	{Group: 0x0010, Element: 0x0102},
+	{Group: 0x0010, Element: 0x1000},
+	{Group: 0x0010, Element: 0x1001},
+	{Group: 0x0010, Element: 0x1002},
+	{Group: 0x0010, Element: 0x1005},
+	{Group: 0x0010, Element: 0x1010},
+	{Group: 0x0010, Element: 0x1020},
+	{Group: 0x0010, Element: 0x1021},
+	{Group: 0x0010, Element: 0x1030},
+	{Group: 0x0010, Element: 0x1040},
+	{Group: 0x0010, Element: 0x1060},
+	{Group: 0x0010, Element: 0x1080},
+	{Group: 0x0010, Element: 0x1081},
+	{Group: 0x0010, Element: 0x1090},
+	{Group: 0x0010, Element: 0x2000},
+	{Group: 0x0010, Element: 0x2110},
+	{Group: 0x0010, Element: 0x2150},
+	{Group: 0x0010, Element: 0x2152},
+	{Group: 0x0010, Element: 0x2154},
+	{Group: 0x0010, Element: 0x2160},
+	{Group: 0x0010, Element: 0x2180},
+	{Group: 0x0010, Element: 0x21A0},
+	{Group: 0x0010, Element: 0x21B0},
+	{Group: 0x0010, Element: 0x21C0},
+	{Group: 0x0010, Element: 0x21D0},
+	{Group: 0x0010, Element: 0x21F0},
+	{Group: 0x0010, Element: 0x2201},
+	{Group: 0x0010, Element: 0x2202},
+	{Group: 0x0010, Element: 0x2203},
+	{Group: 0x0010, Element: 0x2210},
+	{Group: 0x0010, Element: 0x2292},
+	{Group: 0x0010, Element: 0x2293},
+	{Group: 0x0010, Element: 0x2294},
+	{Group: 0x0010, Element: 0x2295},
+	{Group: 0x0010, Element: 0x2296},
+	{Group: 0x0010, Element: 0x2297},
+	{Group: 0x0010, Element: 0x2298},
+	{Group: 0x0010, Element: 0x2299},
+	{Group: 0x0010, Element: 0x4000},
+	{Group: 0x0010, Element: 0x9431},
+	{Group: 0x0012, Element: 0x0010},
+	{Group: 0x0012, Element: 0x0020},
+	{Group: 0x0012, Element: 0x0021},
+	{Group: 0x0012, Element: 0x0030},
+	{Group: 0x0012, Element: 0x0031},
+	{Group: 0x0012, Element: 0x0040},
+	{Group: 0x0012, Element: 0x0042},
+	{Group: 0x0012, Element: 0x0050},
+	{Group: 0x0012, Element: 0x0051},
+	{Group: 0x0012, Element: 0x0060},
+	{Group: 0x0012, Element: 0x0062},
+	{Group: 0x0012, Element: 0x0063},
+	{Group: 0x0012, Element: 0x0064},
+	{Group: 0x0012, Element: 0x0071},
+	{Group: 0x0012, Element: 0x0072},
+	{Group: 0x0012, Element: 0x0081},
+	{Group: 0x0012, Element: 0x0082},
+	{Group: 0x0012, Element: 0x0083},
+	{Group: 0x0012, Element: 0x0084},
+	{Group: 0x0012, Element: 0x0085},
+	{Group: 0x0014, Element: 0x0023},
+	{Group: 0x0014, Element: 0x0024},
+	{Group: 0x0014, Element: 0x0025},
+	{Group: 0x0014, Element: 0x0028},
+	{Group: 0x0014, Element: 0x0030},
+	{Group: 0x0014, Element: 0x0032},
+	{Group: 0x0014, Element: 0x0034},
+	{Group: 0x0014, Element: 0x0042},
+	{Group: 0x0014, Element: 0x0044},
+	{Group: 0x0014, Element: 0x0045},
+	{Group: 0x0014, Element: 0x0046},
+	{Group: 0x0014, Element: 0x0050},
+	{Group: 0x0014, Element: 0x0052},
+	{Group: 0x0014, Element: 0x0054},
+	{Group: 0x0014, Element: 0x0056},
+	{Group: 0x0014, Element: 0x1010},
+	{Group: 0x0014, Element: 0x1020},
+	{Group: 0x0014, Element: 0x1040},
+	{Group: 0x0014, Element: 0x2002},
+	{Group: 0x0014, Element: 0x2004},
+	{Group: 0x0014, Element: 0x2006},
+	{Group: 0x0014, Element: 0x2008},
+	{Group: 0x0014, Element: 0x2012},
+	{Group: 0x0014, Element: 0x2014},
+	{Group: 0x0014, Element: 0x2016},
+	{Group: 0x0014, Element: 0x2018},
+	{Group: 0x0014, Element: 0x201A},
+	{Group: 0x0014, Element: 0x201C},
+	{Group: 0x0014, Element: 0x201E},
+	{Group: 0x0014, Element: 0x2030},
+	{Group: 0x0014, Element: 0x2032},
+	{Group: 0x0014, Element: 0x2202},
+	{Group: 0x0014, Element: 0x2204},
+	{Group: 0x0014, Element: 0x2206},
+	{Group: 0x0014, Element: 0x2208},
+	{Group: 0x0014, Element: 0x220A},
+	{Group: 0x0014, Element: 0x220C},
+	{Group: 0x0014, Element: 0x220E},
+	{Group: 0x0014, Element: 0x2210},
+	{Group: 0x0014, Element: 0x2220},
+	{Group: 0x0014, Element: 0x2222},
+	{Group: 0x0014, Element: 0x2224},
+	{Group: 0x0014, Element: 0x2226},
+	{Group: 0x0014, Element: 0x2228},
+	{Group: 0x0014, Element: 0x222A},
+	{Group: 0x0014, Element: 0x222C},
+	{Group: 0x0014, Element: 0x3011},
+	{Group: 0x0014, Element: 0x3012},
+	{Group: 0x0014, Element: 0x3020},
+	{Group: 0x0014, Element: 0x3022},
+	{Group: 0x0014, Element: 0x3024},
+	{Group: 0x0014, Element: 0x3026},
+	{Group: 0x0014, Element: 0x3028},
+	{Group: 0x0014, Element: 0x3040},
+	{Group: 0x0014, Element: 0x3050},
+	{Group: 0x0014, Element: 0x3060},
+	{Group: 0x0014, Element: 0x3070},
+	{Group: 0x0014, Element: 0x3071},
+	{Group: 0x0014, Element: 0x3072},
+	{Group: 0x0014, Element: 0x3073},
+	{Group: 0x0014, Element: 0x3074},
+	{Group: 0x0014, Element: 0x3075},
+	{Group: 0x0014, Element: 0x3076},
+	{Group: 0x0014, Element: 0x3077},
+	{Group: 0x0014, Element: 0x3080},
+	{Group: 0x0014, Element: 0x3099},
+	{Group: 0x0014, Element: 0x4002},
+	{Group: 0x0014, Element: 0x4004},
+	{Group: 0x0014, Element: 0x4006},
+	{Group: 0x0014, Element: 0x4008},
+	{Group: 0x0014, Element: 0x400A},
+	{Group: 0x0014, Element: 0x400C},
+	{Group: 0x0014, Element: 0x400E},
+	{Group: 0x0014, Element: 0x400F},
+	{Group: 0x0014, Element: 0x4010},
+	{Group: 0x0014, Element: 0x4011},
+	{Group: 0x0014, Element: 0x4012},
+	{Group: 0x0014, Element: 0x4013},
+	{Group: 0x0014, Element: 0x4014},
+	{Group: 0x0014, Element: 0x4015},
+	{Group: 0x0014, Element: 0x4016},
+	{Group: 0x0014, Element: 0x4017},
+	{Group: 0x0014, Element: 0x4018},
+	{Group: 0x0014, Element: 0x4019},
+	{Group: 0x0014, Element: 0x401A},
+	{Group: 0x0014, Element: 0x401B},
+	{Group: 0x0014, Element: 0x401C},
+	{Group: 0x0014, Element: 0x4020},
+	{Group: 0x0014, Element: 0x4022},
+	{Group: 0x0014, Element: 0x4024},
+	{Group: 0x0014, Element: 0x4026},
+	{Group: 0x0014, Element: 0x4028},
+	{Group: 0x0014, Element: 0x4030},
+	{Group: 0x0014, Element: 0x4031},
+	{Group: 0x0014, Element: 0x4032},
+	{Group: 0x0014, Element: 0x4033},
+	{Group: 0x0014, Element: 0x4034},
+	{Group: 0x0014, Element: 0x4035},
+	{Group: 0x0014, Element: 0x4036},
+	{Group: 0x0014, Element: 0x4038},
+	{Group: 0x0014, Element: 0x403A},
+	{Group: 0x0014, Element: 0x403C},
+	{Group: 0x0014, Element: 0x4040},
+	{Group: 0x0014, Element: 0x4050},
+	{Group: 0x0014, Element: 0x4051},
+	{Group: 0x0014, Element: 0x4052},
+	{Group: 0x0014, Element: 0x4054},
+	{Group: 0x0014, Element: 0x4056},
+	{Group: 0x0014, Element: 0x4057},
+	{Group: 0x0014, Element: 0x4058},
+	{Group: 0x0014, Element: 0x4059},
+	{Group: 0x0014, Element: 0x405A},
+	{Group: 0x0014, Element: 0x405C},
+	{Group: 0x0014, Element: 0x4060},
+	{Group: 0x0014, Element: 0x4062},
+	{Group: 0x0014, Element: 0x4064},
+	{Group: 0x0014, Element: 0x4070},
+	{Group: 0x0014, Element: 0x4072},
+	{Group: 0x0014, Element: 0x4074},
+	{Group: 0x0014, Element: 0x4076},
+	{Group: 0x0014, Element: 0x4078},
+	{Group: 0x0014, Element: 0x407A},
+	{Group: 0x0014, Element: 0x407C},
+	{Group: 0x0014, Element: 0x407E},
+	{Group: 0x0014, Element: 0x5002},
+	{Group: 0x0014, Element: 0x5004},
+	{Group: 0x0018, Element: 0x0010},
+	{Group: 0x0018, Element: 0x0012},
+	{Group: 0x0018, Element: 0x0014},
+	{Group: 0x0018, Element: 0x0015},
+	{Group: 0x0018, Element: 0x0020},
+	{Group: 0x0018, Element: 0x0021},
+	{Group: 0x0018, Element: 0x0022},
+	{Group: 0x0018, Element: 0x0023},
+	{Group: 0x0018, Element: 0x0024},
+	{Group: 0x0018, Element: 0x0025},
+	{Group: 0x0018, Element: 0x0026},
+	{Group: 0x0018, Element: 0x0027},
+	{Group: 0x0018, Element: 0x0028},
+	{Group: 0x0018, Element: 0x0029},
+	{Group: 0x0018, Element: 0x002A},
+	{Group: 0x0018, Element: 0x0031},
+	{Group: 0x0018, Element: 0x0034},
+	{Group: 0x0018, Element: 0x0035},
+	{Group: 0x0018, Element: 0x0036},
+	{Group: 0x0018, Element: 0x0038},
+	{Group: 0x0018, Element: 0x003A},
+	{Group: 0x0018, Element: 0x0040},
+	{Group: 0x0018, Element: 0x0042},
+	{Group: 0x0018, Element: 0x0050},
+	{Group: 0x0018, Element: 0x0060},
+	{Group: 0x0018, Element: 0x0070},
+	{Group: 0x0018, Element: 0x0071},
+	{Group: 0x0018, Element: 0x0072},
+	{Group: 0x0018, Element: 0x0073},
+	{Group: 0x0018, Element: 0x0074},
+	{Group: 0x0018, Element: 0x0075},
+	{Group: 0x0018, Element: 0x0080},
+	{Group: 0x0018, Element: 0x0081},
+	{Group: 0x0018, Element: 0x0082},
+	{Group: 0x0018, Element: 0x0083},
+	{Group: 0x0018, Element: 0x0084},
+	{Group: 0x0018, Element: 0x0085},
+	{Group: 0x0018, Element: 0x0086},
+	{Group: 0x0018, Element: 0x0087},
+	{Group: 0x0018, Element: 0x0088},
+	{Group: 0x0018, Element: 0x0089},
+	{Group: 0x0018, Element: 0x0090},
+	{Group: 0x0018, Element: 0x0091},
+	{Group: 0x0018, Element: 0x0093},
+	{Group: 0x0018, Element: 0x0094},
+	{Group: 0x0018, Element: 0x0095},
+	{Group: 0x0018, Element: 0x1000},
+	{Group: 0x0018, Element: 0x1002},
+	{Group: 0x0018, Element: 0x1003},
+	{Group: 0x0018, Element: 0x1004},
+	{Group: 0x0018, Element: 0x1005},
+	{Group: 0x0018, Element: 0x1006},
+	{Group: 0x0018, Element: 0x1007},
+	{Group: 0x0018, Element: 0x1008},
+	{Group: 0x0018, Element: 0x1010},
+	{Group: 0x0018, Element: 0x1012},
+	{Group: 0x0018, Element: 0x1014},
+	{Group: 0x0018, Element: 0x1016},
+	{Group: 0x0018, Element: 0x1018},
+	{Group: 0x0018, Element: 0x1019},
+	{Group: 0x0018, Element: 0x1020},
+	{Group: 0x0018, Element: 0x1022},
+	{Group: 0x0018, Element: 0x1023},
+	{Group: 0x0018, Element: 0x1030},
+	{Group: 0x0018, Element: 0x1040},
+	{Group: 0x0018, Element: 0x1041},
+	{Group: 0x0018, Element: 0x1042},
+	{Group: 0x0018, Element: 0x1043},
+	{Group: 0x0018, Element: 0x1044},
+	{Group: 0x0018, Element: 0x1045},
+	{Group: 0x0018, Element: 0x1046},
+	{Group: 0x0018, Element: 0x1047},
+	{Group: 0x0018, Element: 0x1048},
+	{Group: 0x0018, Element: 0x1049},
+	{Group: 0x0018, Element: 0x1050},
+	{Group: 0x0018, Element: 0x1060},
+	{Group: 0x0018, Element: 0x1061},
+	{Group: 0x0018, Element: 0x1062},
+	{Group: 0x0018, Element: 0x1063},
+	{Group: 0x0018, Element: 0x1064},
+	{Group: 0x0018, Element: 0x1065},
+	{Group: 0x0018, Element: 0x1066},
+	{Group: 0x0018, Element: 0x1067},
+	{Group: 0x0018, Element: 0x1068},
+	{Group: 0x0018, Element: 0x1069},
+	{Group: 0x0018, Element: 0x106A},
+	{Group: 0x0018, Element: 0x106C},
+	{Group: 0x0018, Element: 0x106E},
+	{Group: 0x0018, Element: 0x1070},
+	{Group: 0x0018, Element: 0x1071},
+	{Group: 0x0018, Element: 0x1072},
+	{Group: 0x0018, Element: 0x1073},
+	{Group: 0x0018, Element: 0x1074},
+	{Group: 0x0018, Element: 0x1075},
+	{Group: 0x0018, Element: 0x1076},
+	{Group: 0x0018, Element: 0x1077},
+	{Group: 0x0018, Element: 0x1078},
+	{Group: 0x0018, Element: 0x1079},
+	{Group: 0x0018, Element: 0x1080},
+	{Group: 0x0018, Element: 0x1081},
+	{Group: 0x0018, Element: 0x1082},
+	{Group: 0x0018, Element: 0x1083},
+	{Group: 0x0018, Element: 0x1084},
+	{Group: 0x0018, Element: 0x1085},
+	{Group: 0x0018, Element: 0x1086},
+	{Group: 0x0018, Element: 0x1088},
+	{Group: 0x0018, Element: 0x1090},
+	{Group: 0x0018, Element: 0x1094},
+	{Group: 0x0018, Element: 0x1100},
+	{Group: 0x0018, Element: 0x1110},
+	{Group: 0x0018, Element: 0x1111},
+	{Group: 0x0018, Element: 0x1114},
+	{Group: 0x0018, Element: 0x1120},
+	{Group: 0x0018, Element: 0x1121},
+	{Group: 0x0018, Element: 0x1130},
+	{Group: 0x0018, Element: 0x1131},
+	{Group: 0x0018, Element: 0x1134},
+	{Group: 0x0018, Element: 0x1135},
+	{Group: 0x0018, Element: 0x1136},
+	{Group: 0x0018, Element: 0x1137},
+	{Group: 0x0018, Element: 0x1138},
+	{Group: 0x0018, Element: 0x113A},
+	{Group: 0x0018, Element: 0x1140},
+	{Group: 0x0018, Element: 0x1142},
+	{Group: 0x0018, Element: 0x1143},
+	{Group: 0x0018, Element: 0x1144},
+	{Group: 0x0018, Element: 0x1145},
+	{Group: 0x0018, Element: 0x1147},
+	{Group: 0x0018, Element: 0x1149},
+	{Group: 0x0018, Element: 0x1150},
+	{Group: 0x0018, Element: 0x1151},
+	{Group: 0x0018, Element: 0x1152},
+	{Group: 0x0018, Element: 0x1153},
+	{Group: 0x0018, Element: 0x1154},
+	{Group: 0x0018, Element: 0x1155},
+	{Group: 0x0018, Element: 0x1156},
+	{Group: 0x0018, Element: 0x115A},
+	{Group: 0x0018, Element: 0x115E},
+	{Group: 0x0018, Element: 0x1160},
+	{Group: 0x0018, Element: 0x1161},
+	{Group: 0x0018, Element: 0x1162},
+	{Group: 0x0018, Element: 0x1164},
+	{Group: 0x0018, Element: 0x1166},
+	{Group: 0x0018, Element: 0x1170},
+	{Group: 0x0018, Element: 0x1180},
+	{Group: 0x0018, Element: 0x1181},
+	{Group: 0x0018, Element: 0x1182},
+	{Group: 0x0018, Element: 0x1183},
+	{Group: 0x0018, Element: 0x1184},
+	{Group: 0x0018, Element: 0x1190},
+	{Group: 0x0018, Element: 0x1191},
+	{Group: 0x0018, Element: 0x11A0},
+	{Group: 0x0018, Element: 0x11A2},
+	{Group: 0x0018, Element: 0x1200},
+	{Group: 0x0018, Element: 0x1201},
+	{Group: 0x0018, Element: 0x1210},
+	{Group: 0x0018, Element: 0x1242},
+	{Group: 0x0018, Element: 0x1243},
+	{Group: 0x0018, Element: 0x1244},
+	{Group: 0x0018, Element: 0x1250},
+	{Group: 0x0018, Element: 0x1251},
+	{Group: 0x0018, Element: 0x1260},
+	{Group: 0x0018, Element: 0x1261},
+	{Group: 0x0018, Element: 0x1300},
+	{Group: 0x0018, Element: 0x1301},
+	{Group: 0x0018, Element: 0x1302},
+	{Group: 0x0018, Element: 0x1310},
+	{Group: 0x0018, Element: 0x1312},
+	{Group: 0x0018, Element: 0x1314},
+	{Group: 0x0018, Element: 0x1315},
+	{Group: 0x0018, Element: 0x1316},
+	{Group: 0x0018, Element: 0x1318},
+	{Group: 0x0018, Element: 0x1400},
+	{Group: 0x0018, Element: 0x1401},
+	{Group: 0x0018, Element: 0x1402},
+	{Group: 0x0018, Element: 0x1403},
+	{Group: 0x0018, Element: 0x1404},
+	{Group: 0x0018, Element: 0x1405},
+	{Group: 0x0018, Element: 0x1411},
+	{Group: 0x0018, Element: 0x1412},
+	{Group: 0x0018, Element: 0x1413},
+	{Group: 0x0018, Element: 0x1450},
+	{Group: 0x0018, Element: 0x1460},
+	{Group: 0x0018, Element: 0x1470},
+	{Group: 0x0018, Element: 0x1480},
+	{Group: 0x0018, Element: 0x1490},
+	{Group: 0x0018, Element: 0x1491},
+	{Group: 0x0018, Element: 0x1495},
+	{Group: 0x0018, Element: 0x1500},
+	{Group: 0x0018, Element: 0x1508},
+	{Group: 0x0018, Element: 0x1510},
+	{Group: 0x0018, Element: 0x1511},
+	{Group: 0x0018, Element: 0x1520},
+	{Group: 0x0018, Element: 0x1521},
+	{Group: 0x0018, Element: 0x1530},
+	{Group: 0x0018, Element: 0x1531},
+	{Group: 0x0018, Element: 0x1600},
+	{Group: 0x0018, Element: 0x1602},
+	{Group: 0x0018, Element: 0x1604},
+	{Group: 0x0018, Element: 0x1606},
+	{Group: 0x0018, Element: 0x1608},
+	{Group: 0x0018, Element: 0x1610},
+	{Group: 0x0018, Element: 0x1612},
+	{Group: 0x0018, Element: 0x1620},
+	{Group: 0x0018, Element: 0x1622},
+	{Group: 0x0018, Element: 0x1623},
+	{Group: 0x0018, Element: 0x1624},
+	{Group: 0x0018, Element: 0x1700},
+	{Group: 0x0018, Element: 0x1702},
+	{Group: 0x0018, Element: 0x1704},
+	{Group: 0x0018, Element: 0x1706},
+	{Group: 0x0018, Element: 0x1708},
+	{Group: 0x0018, Element: 0x1710},
+	{Group: 0x0018, Element: 0x1712},
+	{Group: 0x0018, Element: 0x1720},
+	{Group: 0x0018, Element: 0x1800},
+	{Group: 0x0018, Element: 0x1801},
+	{Group: 0x0018, Element: 0x1802},
+	{Group: 0x0018, Element: 0x1803},
+	{Group: 0x0018, Element: 0x2001},
+	{Group: 0x0018, Element: 0x2002},
+	{Group: 0x0018, Element: 0x2003},
+	{Group: 0x0018, Element: 0x2004},
+	{Group: 0x0018, Element: 0x2005},
+	{Group: 0x0018, Element: 0x2006},
+	{Group: 0x0018, Element: 0x2010},
+	{Group: 0x0018, Element: 0x2020},
+	{Group: 0x0018, Element: 0x2030},
+	{Group: 0x0018, Element: 0x3100},
+	{Group: 0x0018, Element: 0x3101},
+	{Group: 0x0018, Element: 0x3102},
+	{Group: 0x0018, Element: 0x3103},
+	{Group: 0x0018, Element: 0x3104},
+	{Group: 0x0018, Element: 0x3105},
+	{Group: 0x0018, Element: 0x5000},
+	{Group: 0x0018, Element: 0x5010},
+	{Group: 0x0018, Element: 0x5012},
+	{Group: 0x0018, Element: 0x5020},
+	{Group: 0x0018, Element: 0x5022},
+	{Group: 0x0018, Element: 0x5024},
+	{Group: 0x0018, Element: 0x5026},
+	{Group: 0x0018, Element: 0x5027},
+	{Group: 0x0018, Element: 0x5028},
+	{Group: 0x0018, Element: 0x5029},
+	{Group: 0x0018, Element: 0x5050},
+	{Group: 0x0018, Element: 0x5100},
+	{Group: 0x0018, Element: 0x5101},
+	{Group: 0x0018, Element: 0x5104},
+	{Group: 0x0018, Element: 0x6000},
+	{Group: 0x0018, Element: 0x6011},
+	{Group: 0x0018, Element: 0x6012},
+	{Group: 0x0018, Element: 0x6014},
+	{Group: 0x0018, Element: 0x6016},
+	{Group: 0x0018, Element: 0x6018},
+	{Group: 0x0018, Element: 0x601A},
+	{Group: 0x0018, Element: 0x601C},
+	{Group: 0x0018, Element: 0x601E},
+	{Group: 0x0018, Element: 0x6020},
+	{Group: 0x0018, Element: 0x6022},
+	{Group: 0x0018, Element: 0x6024},
+	{Group: 0x0018, Element: 0x6026},
+	{Group: 0x0018, Element: 0x6028},
+	{Group: 0x0018, Element: 0x602A},
+	{Group: 0x0018, Element: 0x602C},
+	{Group: 0x0018, Element: 0x602E},
+	{Group: 0x0018, Element: 0x6030},
+	{Group: 0x0018, Element: 0x6031},
+	{Group: 0x0018, Element: 0x6032},
+	{Group: 0x0018, Element: 0x6034},
+	{Group: 0x0018, Element: 0x6036},
+	{Group: 0x0018, Element: 0x6039},
+	{Group: 0x0018, Element: 0x603B},
+	{Group: 0x0018, Element: 0x603D},
+	{Group: 0x0018, Element: 0x603F},
+	{Group: 0x0018, Element: 0x6041},
+	{Group: 0x0018, Element: 0x6043},
+	{Group: 0x0018, Element: 0x6044},
+	{Group: 0x0018, Element: 0x6046},
+	{Group: 0x0018, Element: 0x6048},
+	{Group: 0x0018, Element: 0x604A},
+	{Group: 0x0018, Element: 0x604C},
+	{Group: 0x0018, Element: 0x604E},
+	{Group: 0x0018, Element: 0x6050},
+	{Group: 0x0018, Element: 0x6052},
+	{Group: 0x0018, Element: 0x6054},
+	{Group: 0x0018, Element: 0x6056},
+	{Group: 0x0018, Element: 0x6058},
+	{Group: 0x0018, Element: 0x605A},
+	{Group: 0x0018, Element: 0x6060},
+	{Group: 0x0018, Element: 0x7000},
+	{Group: 0x0018, Element: 0x7001},
+	{Group: 0x0018, Element: 0x7004},
+	{Group: 0x0018, Element: 0x7005},
+	{Group: 0x0018, Element: 0x7006},
+	{Group: 0x0018, Element: 0x7008},
+	{Group: 0x0018, Element: 0x700A},
+	{Group: 0x0018, Element: 0x700C},
+	{Group: 0x0018, Element: 0x700E},
+	{Group: 0x0018, Element: 0x7010},
+	{Group: 0x0018, Element: 0x7011},
+	{Group: 0x0018, Element: 0x7012},
+	{Group: 0x0018, Element: 0x7014},
+	{Group: 0x0018, Element: 0x7016},
+	{Group: 0x0018, Element: 0x701A},
+	{Group: 0x0018, Element: 0x7020},
+	{Group: 0x0018, Element: 0x7022},
+	{Group: 0x0018, Element: 0x7024},
+	{Group: 0x0018, Element: 0x7026},
+	{Group: 0x0018, Element: 0x7028},
+	{Group: 0x0018, Element: 0x702A},
+	{Group: 0x0018, Element: 0x702B},
+	{Group: 0x0018, Element: 0x7030},
+	{Group: 0x0018, Element: 0x7032},
+	{Group: 0x0018, Element: 0x7034},
+	{Group: 0x0018, Element: 0x7036},
+	{Group: 0x0018, Element: 0x7038},
+	{Group: 0x0018, Element: 0x7040},
+	{Group: 0x0018, Element: 0x7041},
+	{Group: 0x0018, Element: 0x7042},
+	{Group: 0x0018, Element: 0x7044},
+	{Group: 0x0018, Element: 0x7046},
+	{Group: 0x0018, Element: 0x7048},
+	{Group: 0x0018, Element: 0x704C},
+	{Group: 0x0018, Element: 0x7050},
+	{Group: 0x0018, Element: 0x7052},
+	{Group: 0x0018, Element: 0x7054},
+	{Group: 0x0018, Element: 0x7056},
+	{Group: 0x0018, Element: 0x7058},
+	{Group: 0x0018, Element: 0x7060},
+	{Group: 0x0018, Element: 0x7062},
+	{Group: 0x0018, Element: 0x7064},
+	{Group: 0x0018, Element: 0x7065},
+	{Group: 0x0018, Element: 0x8150},
+	{Group: 0x0018, Element: 0x8151},
+	{Group: 0x0018, Element: 0x9004},
+	{Group: 0x0018, Element: 0x9005},
+	{Group: 0x0018, Element: 0x9006},
+	{Group: 0x0018, Element: 0x9008},
+	{Group: 0x0018, Element: 0x9009},
+	{Group: 0x0018, Element: 0x9010},
+	{Group: 0x0018, Element: 0x9011},
+	{Group: 0x0018, Element: 0x9012},
+	{Group: 0x0018, Element: 0x9014},
+	{Group: 0x0018, Element: 0x9015},
+	{Group: 0x0018, Element: 0x9016},
+	{Group: 0x0018, Element: 0x9017},
+	{Group: 0x0018, Element: 0x9018},
+	{Group: 0x0018, Element: 0x9019},
+	{Group: 0x0018, Element: 0x9020},
+	{Group: 0x0018, Element: 0x9021},
+	{Group: 0x0018, Element: 0x9022},
+	{Group: 0x0018, Element: 0x9024},
+	{Group: 0x0018, Element: 0x9025},
+	{Group: 0x0018, Element: 0x9026},
+	{Group: 0x0018, Element: 0x9027},
+	{Group: 0x0018, Element: 0x9028},
+	{Group: 0x0018, Element: 0x9029},
+	{Group: 0x0018, Element: 0x9030},
+	{Group: 0x0018, Element: 0x9032},
+	{Group: 0x0018, Element: 0x9033},
+	{Group: 0x0018, Element: 0x9034},
+	{Group: 0x0018, Element: 0x9035},
+	{Group: 0x0018, Element: 0x9036},
+	{Group: 0x0018, Element: 0x9037},
+	{Group: 0x0018, Element: 0x9041},
+	{Group: 0x0018, Element: 0x9042},
+	{Group: 0x0018, Element: 0x9043},
+	{Group: 0x0018, Element: 0x9044},
+	{Group: 0x0018, Element: 0x9045},
+	{Group: 0x0018, Element: 0x9046},
+	{Group: 0x0018, Element: 0x9047},
+	{Group: 0x0018, Element: 0x9048},
+	{Group: 0x0018, Element: 0x9049},
+	{Group: 0x0018, Element: 0x9050},
+	{Group: 0x0018, Element: 0x9051},
+	{Group: 0x0018, Element: 0x9052},
+	{Group: 0x0018, Element: 0x9053},
+	{Group: 0x0018, Element: 0x9054},
+	{Group: 0x0018, Element: 0x9058},
+	{Group: 0x0018, Element: 0x9059},
+	{Group: 0x0018, Element: 0x9060},
+	{Group: 0x0018, Element: 0x9061},
+	{Group: 0x0018, Element: 0x9062},
+	{Group: 0x0018, Element: 0x9063},
+	{Group: 0x0018, Element: 0x9064},
+	{Group: 0x0018, Element: 0x9065},
+	{Group: 0x0018, Element: 0x9066},
+	{Group: 0x0018, Element: 0x9067},
+	{Group: 0x0018, Element: 0x9069},
+	{Group: 0x0018, Element: 0x9070},
+	{Group: 0x0018, Element: 0x9073},
+	{Group: 0x0018, Element: 0x9074},
+	{Group: 0x0018, Element: 0x9075},
+	{Group: 0x0018, Element: 0x9076},
+	{Group: 0x0018, Element: 0x9077},
+	{Group: 0x0018, Element: 0x9078},
+	{Group: 0x0018, Element: 0x9079},
+	{Group: 0x0018, Element: 0x9080},
+	{Group: 0x0018, Element: 0x9081},
+	{Group: 0x0018, Element: 0x9082},
+	{Group: 0x0018, Element: 0x9083},
+	{Group: 0x0018, Element: 0x9084},
+	{Group: 0x0018, Element: 0x9085},
+	{Group: 0x0018, Element: 0x9087},
+	{Group: 0x0018, Element: 0x9089},
+	{Group: 0x0018, Element: 0x9090},
+	{Group: 0x0018, Element: 0x9091},
+	{Group: 0x0018, Element: 0x9092},
+	{Group: 0x0018, Element: 0x9093},
+	{Group: 0x0018, Element: 0x9094},
+	{Group: 0x0018, Element: 0x9095},
+	{Group: 0x0018, Element: 0x9098},
+	{Group: 0x0018, Element: 0x9100},
+	{Group: 0x0018, Element: 0x9101},
+	{Group: 0x0018, Element: 0x9103},
+	{Group: 0x0018, Element: 0x9104},
+	{Group: 0x0018, Element: 0x9105},
+	{Group: 0x0018, Element: 0x9106},
+	{Group: 0x0018, Element: 0x9107},
+	{Group: 0x0018, Element: 0x9112},
+	{Group: 0x0018, Element: 0x9114},
+	{Group: 0x0018, Element: 0x9115},
+	{Group: 0x0018, Element: 0x9117},
+	{Group: 0x0018, Element: 0x9118},
+	{Group: 0x0018, Element: 0x9119},
+	{Group: 0x0018, Element: 0x9125},
+	{Group: 0x0018, Element: 0x9126},
+	{Group: 0x0018, Element: 0x9127},
+	{Group: 0x0018, Element: 0x9147},
+	{Group: 0x0018, Element: 0x9151},
+	{Group: 0x0018, Element: 0x9152},
+	{Group: 0x0018, Element: 0x9155},
+	{Group: 0x0018, Element: 0x9159},
+	{Group: 0x0018, Element: 0x9168},
+	{Group: 0x0018, Element: 0x9169},
+	{Group: 0x0018, Element: 0x9170},
+	{Group: 0x0018, Element: 0x9171},
+	{Group: 0x0018, Element: 0x9172},
+	{Group: 0x0018, Element: 0x9173},
+	{Group: 0x0018, Element: 0x9174},
+	{Group: 0x0018, Element: 0x9175},
+	{Group: 0x0018, Element: 0x9176},
+	{Group: 0x0018, Element: 0x9177},
+	{Group: 0x0018, Element: 0x9178},
+	{Group: 0x0018, Element: 0x9179},
+	{Group: 0x0018, Element: 0x9180},
+	{Group: 0x0018, Element: 0x9181},
+	{Group: 0x0018, Element: 0x9182},
+	{Group: 0x0018, Element: 0x9183},
+	{Group: 0x0018, Element: 0x9184},
+	{Group: 0x0018, Element: 0x9185},
+	{Group: 0x0018, Element: 0x9186},
+	{Group: 0x0018, Element: 0x9197},
+	{Group: 0x0018, Element: 0x9198},
+	{Group: 0x0018, Element: 0x9199},
+	{Group: 0x0018, Element: 0x9200},
+	{Group: 0x0018, Element: 0x9214},
+	{Group: 0x0018, Element: 0x9217},
+	{Group: 0x0018, Element: 0x9218},
+	{Group: 0x0018, Element: 0x9219},
+	{Group: 0x0018, Element: 0x9220},
+	{Group: 0x0018, Element: 0x9226},
+	{Group: 0x0018, Element: 0x9227},
+	{Group: 0x0018, Element: 0x9231},
+	{Group: 0x0018, Element: 0x9232},
+	{Group: 0x0018, Element: 0x9234},
+	{Group: 0x0018, Element: 0x9236},
+	{Group: 0x0018, Element: 0x9239},
+	{Group: 0x0018, Element: 0x9240},
+	{Group: 0x0018, Element: 0x9241},
+	{Group: 0x0018, Element: 0x9250},
+	{Group: 0x0018, Element: 0x9251},
+	{Group: 0x0018, Element: 0x9252},
+	{Group: 0x0018, Element: 0x9253},
+	{Group: 0x0018, Element: 0x9254},
+	{Group: 0x0018, Element: 0x9255},
+	{Group: 0x0018, Element: 0x9256},
+	{Group: 0x0018, Element: 0x9257},
+	{Group: 0x0018, Element: 0x9258},
+	{Group: 0x0018, Element: 0x9259},
+	{Group: 0x0018, Element: 0x925A},
+	{Group: 0x0018, Element: 0x925B},
+	{Group: 0x0018, Element: 0x925C},
+	{Group: 0x0018, Element: 0x925D},
+	{Group: 0x0018, Element: 0x925E},
+	{Group: 0x0018, Element: 0x925F},
+	{Group: 0x0018, Element: 0x9260},
+	{Group: 0x0018, Element: 0x9295},
+	{Group: 0x0018, Element: 0x9296},
+	{Group: 0x0018, Element: 0x9301},
+	{Group: 0x0018, Element: 0x9302},
+	{Group: 0x0018, Element: 0x9303},
+	{Group: 0x0018, Element: 0x9304},
+	{Group: 0x0018, Element: 0x9305},
+	{Group: 0x0018, Element: 0x9306},
+	{Group: 0x0018, Element: 0x9307},
+	{Group: 0x0018, Element: 0x9308},
+	{Group: 0x0018, Element: 0x9309},
+	{Group: 0x0018, Element: 0x9310},
+	{Group: 0x0018, Element: 0x9311},
+	{Group: 0x0018, Element: 0x9312},
+	{Group: 0x0018, Element: 0x9313},
+	{Group: 0x0018, Element: 0x9314},
+	{Group: 0x0018, Element: 0x9315},
+	{Group: 0x0018, Element: 0x9316},
+	{Group: 0x0018, Element: 0x9317},
+	{Group: 0x0018, Element: 0x9318},
+	{Group: 0x0018, Element: 0x9319},
+	{Group: 0x0018, Element: 0x9320},
+	{Group: 0x0018, Element: 0x9321},
+	{Group: 0x0018, Element: 0x9322},
+	{Group: 0x0018, Element: 0x9323},
+	{Group: 0x0018, Element: 0x9324},
+	{Group: 0x0018, Element: 0x9325},
+	{Group: 0x0018, Element: 0x9326},
+	{Group: 0x0018, Element: 0x9327},
+	{Group: 0x0018, Element: 0x9328},
+	{Group: 0x0018, Element: 0x9329},
+	{Group: 0x0018, Element: 0x9330},
+	{Group: 0x0018, Element: 0x9332},
+	{Group: 0x0018, Element: 0x9333},
+	{Group: 0x0018, Element: 0x9334},
+	{Group: 0x0018, Element: 0x9335},
+	{Group: 0x0018, Element: 0x9337},
+	{Group: 0x0018, Element: 0x9338},
+	{Group: 0x0018, Element: 0x9340},
+	{Group: 0x0018, Element: 0x9341},
+	{Group: 0x0018, Element: 0x9342},
+	{Group: 0x0018, Element: 0x9343},
+	{Group: 0x0018, Element: 0x9344},
+	{Group: 0x0018, Element: 0x9345},
+	{Group: 0x0018, Element: 0x9346},
+	{Group: 0x0018, Element: 0x9351},
+	{Group: 0x0018, Element: 0x9352},
+	{Group: 0x0018, Element: 0x9353},
+	{Group: 0x0018, Element: 0x9360},
+	{Group: 0x0018, Element: 0x9401},
+	{Group: 0x0018, Element: 0x9402},
+	{Group: 0x0018, Element: 0x9403},
+	{Group: 0x0018, Element: 0x9404},
+	{Group: 0x0018, Element: 0x9405},
+	{Group: 0x0018, Element: 0x9406},
+	{Group: 0x0018, Element: 0x9407},
+	{Group: 0x0018, Element: 0x9410},
+	{Group: 0x0018, Element: 0x9412},
+	{Group: 0x0018, Element: 0x9417},
+	{Group: 0x0018, Element: 0x9420},
+	{Group: 0x0018, Element: 0x9423},
+	{Group: 0x0018, Element: 0x9424},
+	{Group: 0x0018, Element: 0x9425},
+	{Group: 0x0018, Element: 0x9426},
+	{Group: 0x0018, Element: 0x9427},
+	{Group: 0x0018, Element: 0x9428},
+	{Group: 0x0018, Element: 0x9429},
+	{Group: 0x0018, Element: 0x9430},
+	{Group: 0x0018, Element: 0x9432},
+	{Group: 0x0018, Element: 0x9433},
+	{Group: 0x0018, Element: 0x9434},
+	{Group: 0x0018, Element: 0x9435},
+	{Group: 0x0018, Element: 0x9436},
+	{Group: 0x0018, Element: 0x9437},
+	{Group: 0x0018, Element: 0x9438},
+	{Group: 0x0018, Element: 0x9439},
+	{Group: 0x0018, Element: 0x9440},
+	{Group: 0x0018, Element: 0x9441},
+	{Group: 0x0018, Element: 0x9442},
+	{Group: 0x0018, Element: 0x9447},
+	{Group: 0x0018, Element: 0x9449},
+	{Group: 0x0018, Element: 0x9451},
+	{Group: 0x0018, Element: 0x9452},
+	{Group: 0x0018, Element: 0x9455},
+	{Group: 0x0018, Element: 0x9456},
+	{Group: 0x0018, Element: 0x9457},
+	{Group: 0x0018, Element: 0x9461},
+	{Group: 0x0018, Element: 0x9462},
+	{Group: 0x0018, Element: 0x9463},
+	{Group: 0x0018, Element: 0x9464},
+	{Group: 0x0018, Element: 0x9465},
+	{Group: 0x0018, Element: 0x9466},
+	{Group: 0x0018, Element: 0x9467},
+	{Group: 0x0018, Element: 0x9468},
+	{Group: 0x0018, Element: 0x9469},
+	{Group: 0x0018, Element: 0x9470},
+	{Group: 0x0018, Element: 0x9471},
+	{Group: 0x0018, Element: 0x9472},
+	{Group: 0x0018, Element: 0x9473},
+	{Group: 0x0018, Element: 0x9474},
+	{Group: 0x0018, Element: 0x9476},
+	{Group: 0x0018, Element: 0x9477},
+	{Group: 0x0018, Element: 0x9504},
+	{Group: 0x0018, Element: 0x9506},
+	{Group: 0x0018, Element: 0x9507},
+	{Group: 0x0018, Element: 0x9508},
+	{Group: 0x0018, Element: 0x9509},
+	{Group: 0x0018, Element: 0x9510},
+	{Group: 0x0018, Element: 0x9511},
+	{Group: 0x0018, Element: 0x9514},
+	{Group: 0x0018, Element: 0x9515},
+	{Group: 0x0018, Element: 0x9516},
+	{Group: 0x0018, Element: 0x9517},
+	{Group: 0x0018, Element: 0x9524},
+	{Group: 0x0018, Element: 0x9525},
+	{Group: 0x0018, Element: 0x9526},
+	{Group: 0x0018, Element: 0x9527},
+	{Group: 0x0018, Element: 0x9528},
+	{Group: 0x0018, Element: 0x9530},
+	{Group: 0x0018, Element: 0x9531},
+	{Group: 0x0018, Element: 0x9538},
+	{Group: 0x0018, Element: 0x9601},
+	{Group: 0x0018, Element: 0x9602},
+	{Group: 0x0018, Element: 0x9603},
+	{Group: 0x0018, Element: 0x9604},
+	{Group: 0x0018, Element: 0x9605},
+	{Group: 0x0018, Element: 0x9606},
+	{Group: 0x0018, Element: 0x9607},
+	{Group: 0x0018, Element: 0x9701},
+	{Group: 0x0018, Element: 0x9715},
+	{Group: 0x0018, Element: 0x9716},
+	{Group: 0x0018, Element: 0x9717},
+	{Group: 0x0018, Element: 0x9718},
+	{Group: 0x0018, Element: 0x9719},
+	{Group: 0x0018, Element: 0x9720},
+	{Group: 0x0018, Element: 0x9721},
+	{Group: 0x0018, Element: 0x9722},
+	{Group: 0x0018, Element: 0x9723},
+	{Group: 0x0018, Element: 0x9724},
+	{Group: 0x0018, Element: 0x9725},
+	{Group: 0x0018, Element: 0x9726},
+	{Group: 0x0018, Element: 0x9727},
+	{Group: 0x0018, Element: 0x9729},
+	{Group: 0x0018, Element: 0x9732},
+	{Group: 0x0018, Element: 0x9733},
+	{Group: 0x0018, Element: 0x9734},
+	{Group: 0x0018, Element: 0x9735},
+	{Group: 0x0018, Element: 0x9736},
+	{Group: 0x0018, Element: 0x9737},
+	{Group: 0x0018, Element: 0x9738},
+	{Group: 0x0018, Element: 0x9739},
+	{Group: 0x0018, Element: 0x9740},
+	{Group: 0x0018, Element: 0x9749},
+	{Group: 0x0018, Element: 0x9751},
+	{Group: 0x0018, Element: 0x9755},
+	{Group: 0x0018, Element: 0x9756},
+	{Group: 0x0018, Element: 0x9758},
+	{Group: 0x0018, Element: 0x9759},
+	{Group: 0x0018, Element: 0x9760},
+	{Group: 0x0018, Element: 0x9761},
+	{Group: 0x0018, Element: 0x9762},
+	{Group: 0x0018, Element: 0x9763},
+	{Group: 0x0018, Element: 0x9764},
+	{Group: 0x0018, Element: 0x9765},
+	{Group: 0x0018, Element: 0x9766},
+	{Group: 0x0018, Element: 0x9767},
+	{Group: 0x0018, Element: 0x9768},
+	{Group: 0x0018, Element: 0x9769},
+	{Group: 0x0018, Element: 0x9770},
+	{Group: 0x0018, Element: 0x9771},
+	{Group: 0x0018, Element: 0x9772},
+	{Group: 0x0018, Element: 0x9801},
+	{Group: 0x0018, Element: 0x9803},
+	{Group: 0x0018, Element: 0x9804},
+	{Group: 0x0018, Element: 0x9805},
+	{Group: 0x0018, Element: 0x9806},
+	{Group: 0x0018, Element: 0x9807},
+	{Group: 0x0018, Element: 0x9808},
+	{Group: 0x0018, Element: 0x9809},
+	{Group: 0x0018, Element: 0x980B},
+	{Group: 0x0018, Element: 0x980C},
+	{Group: 0x0018, Element: 0x980D},
+	{Group: 0x0018, Element: 0x980E},
+	{Group: 0x0018, Element: 0x980F},
+	{Group: 0x0018, Element: 0xA001},
+	{Group: 0x0018, Element: 0xA002},
+	{Group: 0x0018, Element: 0xA003},
+	{Group: 0x0020, Element: 0x000D},
+	{Group: 0x0020, Element: 0x000E},
+	{Group: 0x0020, Element: 0x0010},
+	{Group: 0x0020, Element: 0x0011},
+	{Group: 0x0020, Element: 0x0012},
+	{Group: 0x0020, Element: 0x0013},
+	{Group: 0x0020, Element: 0x0019},
+	{Group: 0x0020, Element: 0x0020},
+	{Group: 0x0020, Element: 0x0032},
+	{Group: 0x0020, Element: 0x0037},
+	{Group: 0x0020, Element: 0x0052},
+	{Group: 0x0020, Element: 0x0060},
+	{Group: 0x0020, Element: 0x0062},
+	{Group: 0x0020, Element: 0x0100},
+	{Group: 0x0020, Element: 0x0105},
+	{Group: 0x0020, Element: 0x0110},
+	{Group: 0x0020, Element: 0x0200},
+	{Group: 0x0020, Element: 0x0242},
+	{Group: 0x0020, Element: 0x1002},
+	{Group: 0x0020, Element: 0x1040},
+	{Group: 0x0020, Element: 0x1041},
+	{Group: 0x0020, Element: 0x1200},
+	{Group: 0x0020, Element: 0x1202},
+	{Group: 0x0020, Element: 0x1204},
+	{Group: 0x0020, Element: 0x1206},
+	{Group: 0x0020, Element: 0x1208},
+	{Group: 0x0020, Element: 0x1209},
+	{Group: 0x0020, Element: 0x4000},
+	{Group: 0x0020, Element: 0x9056},
+	{Group: 0x0020, Element: 0x9057},
+	{Group: 0x0020, Element: 0x9071},
+	{Group: 0x0020, Element: 0x9072},
+	{Group: 0x0020, Element: 0x9111},
+	{Group: 0x0020, Element: 0x9113},
+	{Group: 0x0020, Element: 0x9116},
+	{Group: 0x0020, Element: 0x9128},
+	{Group: 0x0020, Element: 0x9153},
+	{Group: 0x0020, Element: 0x9154},
+	{Group: 0x0020, Element: 0x9155},
+	{Group: 0x0020, Element: 0x9156},
+	{Group: 0x0020, Element: 0x9157},
+	{Group: 0x0020, Element: 0x9158},
+	{Group: 0x0020, Element: 0x9161},
+	{Group: 0x0020, Element: 0x9162},
+	{Group: 0x0020, Element: 0x9163},
+	{Group: 0x0020, Element: 0x9164},
+	{Group: 0x0020, Element: 0x9165},
+	{Group: 0x0020, Element: 0x9167},
+	{Group: 0x0020, Element: 0x9213},
+	{Group: 0x0020, Element: 0x9221},
+	{Group: 0x0020, Element: 0x9222},
+	{Group: 0x0020, Element: 0x9228},
+	{Group: 0x0020, Element: 0x9238},
+	{Group: 0x0020, Element: 0x9241},
+	{Group: 0x0020, Element: 0x9245},
+	{Group: 0x0020, Element: 0x9246},
+	{Group: 0x0020, Element: 0x9247},
+	{Group: 0x0020, Element: 0x9248},
+	{Group: 0x0020, Element: 0x9249},
+	{Group: 0x0020, Element: 0x9250},
+	{Group: 0x0020, Element: 0x9251},
+	{Group: 0x0020, Element: 0x9252},
+	{Group: 0x0020, Element: 0x9253},
+	{Group: 0x0020, Element: 0x9254},
+	{Group: 0x0020, Element: 0x9255},
+	{Group: 0x0020, Element: 0x9256},
+	{Group: 0x0020, Element: 0x9257},
+	{Group: 0x0020, Element: 0x9301},
+	{Group: 0x0020, Element: 0x9302},
+	{Group: 0x0020, Element: 0x9307},
+	{Group: 0x0020, Element: 0x9308},
+	{Group: 0x0020, Element: 0x9309},
+	{Group: 0x0020, Element: 0x930A},
+	{Group: 0x0020, Element: 0x930C},
+	{Group: 0x0020, Element: 0x930D},
+	{Group: 0x0020, Element: 0x930E},
+	{Group: 0x0020, Element: 0x930F},
+	{Group: 0x0020, Element: 0x9310},
+	{Group: 0x0020, Element: 0x9311},
+	{Group: 0x0020, Element: 0x9312},
+	{Group: 0x0020, Element: 0x9313},
+	{Group: 0x0020, Element: 0x9421},
+	{Group: 0x0020, Element: 0x9450},
+	{Group: 0x0020, Element: 0x9453},
+	{Group: 0x0020, Element: 0x9518},
+	{Group: 0x0020, Element: 0x9529},
+	{Group: 0x0020, Element: 0x9536},
+	{Group: 0x0022, Element: 0x0001},
+	{Group: 0x0022, Element: 0x0002},
+	{Group: 0x0022, Element: 0x0003},
+	{Group: 0x0022, Element: 0x0004},
+	{Group: 0x0022, Element: 0x0005},
+	{Group: 0x0022, Element: 0x0006},
+	{Group: 0x0022, Element: 0x0007},
+	{Group: 0x0022, Element: 0x0008},
+	{Group: 0x0022, Element: 0x0009},
+	{Group: 0x0022, Element: 0x000A},
+	{Group: 0x0022, Element: 0x000B},
+	{Group: 0x0022, Element: 0x000C},
+	{Group: 0x0022, Element: 0x000D},
+	{Group: 0x0022, Element: 0x000E},
+	{Group: 0x0022, Element: 0x0010},
+	{Group: 0x0022, Element: 0x0011},
+	{Group: 0x0022, Element: 0x0012},
+	{Group: 0x0022, Element: 0x0013},
+	{Group: 0x0022, Element: 0x0014},
+	{Group: 0x0022, Element: 0x0015},
+	{Group: 0x0022, Element: 0x0016},
+	{Group: 0x0022, Element: 0x0017},
+	{Group: 0x0022, Element: 0x0018},
+	{Group: 0x0022, Element: 0x0019},
+	{Group: 0x0022, Element: 0x001A},
+	{Group: 0x0022, Element: 0x001B},
+	{Group: 0x0022, Element: 0x001C},
+	{Group: 0x0022, Element: 0x001D},
+	{Group: 0x0022, Element: 0x001E},
+	{Group: 0x0022, Element: 0x0020},
+	{Group: 0x0022, Element: 0x0021},
+	{Group: 0x0022, Element: 0x0022},
+	{Group: 0x0022, Element: 0x0030},
+	{Group: 0x0022, Element: 0x0031},
+	{Group: 0x0022, Element: 0x0032},
+	{Group: 0x0022, Element: 0x0035},
+	{Group: 0x0022, Element: 0x0036},
+	{Group: 0x0022, Element: 0x0037},
+	{Group: 0x0022, Element: 0x0038},
+	{Group: 0x0022, Element: 0x0039},
+	{Group: 0x0022, Element: 0x0041},
+	{Group: 0x0022, Element: 0x0042},
+	{Group: 0x0022, Element: 0x0048},
+	{Group: 0x0022, Element: 0x0049},
+	{Group: 0x0022, Element: 0x004E},
+	{Group: 0x0022, Element: 0x0055},
+	{Group: 0x0022, Element: 0x0056},
+	{Group: 0x0022, Element: 0x0057},
+	{Group: 0x0022, Element: 0x0058},
+	{Group: 0x0022, Element: 0x1007},
+	{Group: 0x0022, Element: 0x1008},
+	{Group: 0x0022, Element: 0x1009},
+	{Group: 0x0022, Element: 0x1010},
+	{Group: 0x0022, Element: 0x1012},
+	{Group: 0x0022, Element: 0x1019},
+	{Group: 0x0022, Element: 0x1024},
+	{Group: 0x0022, Element: 0x1025},
+	{Group: 0x0022, Element: 0x1028},
+	{Group: 0x0022, Element: 0x1029},
+	{Group: 0x0022, Element: 0x1033},
+	{Group: 0x0022, Element: 0x1035},
+	{Group: 0x0022, Element: 0x1037},
+	{Group: 0x0022, Element: 0x1039},
+	{Group: 0x0022, Element: 0x1040},
+	{Group: 0x0022, Element: 0x1044},
+	{Group: 0x0022, Element: 0x1050},
+	{Group: 0x0022, Element: 0x1053},
+	{Group: 0x0022, Element: 0x1054},
+	{Group: 0x0022, Element: 0x1059},
+	{Group: 0x0022, Element: 0x1065},
+	{Group: 0x0022, Element: 0x1066},
+	{Group: 0x0022, Element: 0x1090},
+	{Group: 0x0022, Element: 0x1092},
+	{Group: 0x0022, Element: 0x1093},
+	{Group: 0x0022, Element: 0x1094},
+	{Group: 0x0022, Element: 0x1095},
+	{Group: 0x0022, Element: 0x1096},
+	{Group: 0x0022, Element: 0x1097},
+	{Group: 0x0022, Element: 0x1100},
+	{Group: 0x0022, Element: 0x1101},
+	{Group: 0x0022, Element: 0x1103},
+	{Group: 0x0022, Element: 0x1121},
+	{Group: 0x0022, Element: 0x1122},
+	{Group: 0x0022, Element: 0x1125},
+	{Group: 0x0022, Element: 0x1127},
+	{Group: 0x0022, Element: 0x1128},
+	{Group: 0x0022, Element: 0x1130},
+	{Group: 0x0022, Element: 0x1131},
+	{Group: 0x0022, Element: 0x1132},
+	{Group: 0x0022, Element: 0x1133},
+	{Group: 0x0022, Element: 0x1134},
+	{Group: 0x0022, Element: 0x1135},
+	{Group: 0x0022, Element: 0x1140},
+	{Group: 0x0022, Element: 0x1150},
+	{Group: 0x0022, Element: 0x1153},
+	{Group: 0x0022, Element: 0x1155},
+	{Group: 0x0022, Element: 0x1159},
+	{Group: 0x0022, Element: 0x1210},
+	{Group: 0x0022, Element: 0x1211},
+	{Group: 0x0022, Element: 0x1212},
+	{Group: 0x0022, Element: 0x1220},
+	{Group: 0x0022, Element: 0x1225},
+	{Group: 0x0022, Element: 0x1230},
+	{Group: 0x0022, Element: 0x1250},
+	{Group: 0x0022, Element: 0x1255},
+	{Group: 0x0022, Element: 0x1257},
+	{Group: 0x0022, Element: 0x1260},
+	{Group: 0x0022, Element: 0x1262},
+	{Group: 0x0022, Element: 0x1265},
+	{Group: 0x0022, Element: 0x1273},
+	{Group: 0x0022, Element: 0x1300},
+	{Group: 0x0022, Element: 0x1310},
+	{Group: 0x0022, Element: 0x1330},
+	{Group: 0x0024, Element: 0x0010},
+	{Group: 0x0024, Element: 0x0011},
+	{Group: 0x0024, Element: 0x0012},
+	{Group: 0x0024, Element: 0x0016},
+	{Group: 0x0024, Element: 0x0018},
+	{Group: 0x0024, Element: 0x0020},
+	{Group: 0x0024, Element: 0x0021},
+	{Group: 0x0024, Element: 0x0024},
+	{Group: 0x0024, Element: 0x0025},
+	{Group: 0x0024, Element: 0x0028},
+	{Group: 0x0024, Element: 0x0032},
+	{Group: 0x0024, Element: 0x0033},
+	{Group: 0x0024, Element: 0x0034},
+	{Group: 0x0024, Element: 0x0035},
+	{Group: 0x0024, Element: 0x0036},
+	{Group: 0x0024, Element: 0x0037},
+	{Group: 0x0024, Element: 0x0038},
+	{Group: 0x0024, Element: 0x0039},
+	{Group: 0x0024, Element: 0x0040},
+	{Group: 0x0024, Element: 0x0042},
+	{Group: 0x0024, Element: 0x0044},
+	{Group: 0x0024, Element: 0x0045},
+	{Group: 0x0024, Element: 0x0046},
+	{Group: 0x0024, Element: 0x0048},
+	{Group: 0x0024, Element: 0x0050},
+	{Group: 0x0024, Element: 0x0051},
+	{Group: 0x0024, Element: 0x0052},
+	{Group: 0x0024, Element: 0x0053},
+	{Group: 0x0024, Element: 0x0054},
+	{Group: 0x0024, Element: 0x0055},
+	{Group: 0x0024, Element: 0x0056},
+	{Group: 0x0024, Element: 0x0057},
+	{Group: 0x0024, Element: 0x0058},
+	{Group: 0x0024, Element: 0x0059},
+	{Group: 0x0024, Element: 0x0060},
+	{Group: 0x0024, Element: 0x0061},
+	{Group: 0x0024, Element: 0x0062},
+	{Group: 0x0024, Element: 0x0063},
+	{Group: 0x0024, Element: 0x0064},
+	{Group: 0x0024, Element: 0x0065},
+	{Group: 0x0024, Element: 0x0066},
+	{Group: 0x0024, Element: 0x0067},
+	{Group: 0x0024, Element: 0x0068},
+	{Group: 0x0024, Element: 0x0069},
+	{Group: 0x0024, Element: 0x0070},
+	{Group: 0x0024, Element: 0x0071},
+	{Group: 0x0024, Element: 0x0072},
+	{Group: 0x0024, Element: 0x0073},
+	{Group: 0x0024, Element: 0x0074},
+	{Group: 0x0024, Element: 0x0075},
+	{Group: 0x0024, Element: 0x0076},
+	{Group: 0x0024, Element: 0x0077},
+	{Group: 0x0024, Element: 0x0078},
+	{Group: 0x0024, Element: 0x0079},
+	{Group: 0x0024, Element: 0x0080},
+	{Group: 0x0024, Element: 0x0081},
+	{Group: 0x0024, Element: 0x0083},
+	{Group: 0x0024, Element: 0x0085},
+	{Group: 0x0024, Element: 0x0086},
+	{Group: 0x0024, Element: 0x0087},
+	{Group: 0x0024, Element: 0x0088},
+	{Group: 0x0024, Element: 0x0089},
+	{Group: 0x0024, Element: 0x0090},
+	{Group: 0x0024, Element: 0x0091},
+	{Group: 0x0024, Element: 0x0092},
+	{Group: 0x0024, Element: 0x0093},
+	{Group: 0x0024, Element: 0x0094},
+	{Group: 0x0024, Element: 0x0095},
+	{Group: 0x0024, Element: 0x0096},
+	{Group: 0x0024, Element: 0x0097},
+	{Group: 0x0024, Element: 0x0098},
+	{Group: 0x0024, Element: 0x0100},
+	{Group: 0x0024, Element: 0x0102},
+	{Group: 0x0024, Element: 0x0103},
+	{Group: 0x0024, Element: 0x0104},
+	{Group: 0x0024, Element: 0x0105},
+	{Group: 0x0024, Element: 0x0106},
+	{Group: 0x0024, Element: 0x0107},
+	{Group: 0x0024, Element: 0x0108},
+	{Group: 0x0024, Element: 0x0110},
+	{Group: 0x0024, Element: 0x0112},
+	{Group: 0x0024, Element: 0x0113},
+	{Group: 0x0024, Element: 0x0114},
+	{Group: 0x0024, Element: 0x0115},
+	{Group: 0x0024, Element: 0x0117},
+	{Group: 0x0024, Element: 0x0118},
+	{Group: 0x0024, Element: 0x0120},
+	{Group: 0x0024, Element: 0x0122},
+	{Group: 0x0024, Element: 0x0124},
+	{Group: 0x0024, Element: 0x0126},
+	{Group: 0x0024, Element: 0x0202},
+	{Group: 0x0024, Element: 0x0306},
+	{Group: 0x0024, Element: 0x0307},
+	{Group: 0x0024, Element: 0x0308},
+	{Group: 0x0024, Element: 0x0309},
+	{Group: 0x0024, Element: 0x0317},
+	{Group: 0x0024, Element: 0x0320},
+	{Group: 0x0024, Element: 0x0325},
+	{Group: 0x0024, Element: 0x0338},
+	{Group: 0x0024, Element: 0x0341},
+	{Group: 0x0024, Element: 0x0344},
+	{Group: 0x0028, Element: 0x0002},
+	{Group: 0x0028, Element: 0x0003},
+	{Group: 0x0028, Element: 0x0004},
+	{Group: 0x0028, Element: 0x0006},
+	{Group: 0x0028, Element: 0x0008},
+	{Group: 0x0028, Element: 0x0009},
+	{Group: 0x0028, Element: 0x000A},
+	{Group: 0x0028, Element: 0x0010},
+	{Group: 0x0028, Element: 0x0011},
+	{Group: 0x0028, Element: 0x0014},
+	{Group: 0x0028, Element: 0x0030},
+	{Group: 0x0028, Element: 0x0031},
+	{Group: 0x0028, Element: 0x0032},
+	{Group: 0x0028, Element: 0x0034},
+	{Group: 0x0028, Element: 0x0051},
+	{Group: 0x0028, Element: 0x0100},
+	{Group: 0x0028, Element: 0x0101},
+	{Group: 0x0028, Element: 0x0102},
+	{Group: 0x0028, Element: 0x0103},
+	{Group: 0x0028, Element: 0x0106},
+	{Group: 0x0028, Element: 0x0107},
+	{Group: 0x0028, Element: 0x0108},
+	{Group: 0x0028, Element: 0x0109},
+	{Group: 0x0028, Element: 0x0120},
+	{Group: 0x0028, Element: 0x0121},
+	{Group: 0x0028, Element: 0x0300},
+	{Group: 0x0028, Element: 0x0301},
+	{Group: 0x0028, Element: 0x0302},
+	{Group: 0x0028, Element: 0x0303},
+	{Group: 0x0028, Element: 0x0304},
+	{Group: 0x0028, Element: 0x0A02},
+	{Group: 0x0028, Element: 0x0A04},
+	{Group: 0x0028, Element: 0x1040},
+	{Group: 0x0028, Element: 0x1041},
+	{Group: 0x0028, Element: 0x1050},
+	{Group: 0x0028, Element: 0x1051},
+	{Group: 0x0028, Element: 0x1052},
+	{Group: 0x0028, Element: 0x1053},
+	{Group: 0x0028, Element: 0x1054},
+	{Group: 0x0028, Element: 0x1055},
+	{Group: 0x0028, Element: 0x1056},
+	{Group: 0x0028, Element: 0x1090},
+	{Group: 0x0028, Element: 0x1101},
+	{Group: 0x0028, Element: 0x1102},
+	{Group: 0x0028, Element: 0x1103},
+	{Group: 0x0028, Element: 0x1104},
+	{Group: 0x0028, Element: 0x1199},
+	{Group: 0x0028, Element: 0x1201},
+	{Group: 0x0028, Element: 0x1202},
+	{Group: 0x0028, Element: 0x1203},
+	{Group: 0x0028, Element: 0x1204},
+	{Group: 0x0028, Element: 0x1221},
+	{Group: 0x0028, Element: 0x1222},
+	{Group: 0x0028, Element: 0x1223},
+	{Group: 0x0028, Element: 0x1300},
+	{Group: 0x0028, Element: 0x1350},
+	{Group: 0x0028, Element: 0x1351},
+	{Group: 0x0028, Element: 0x1352},
+	{Group: 0x0028, Element: 0x135A},
+	{Group: 0x0028, Element: 0x1401},
+	{Group: 0x0028, Element: 0x1402},
+	{Group: 0x0028, Element: 0x1403},
+	{Group: 0x0028, Element: 0x1404},
+	{Group: 0x0028, Element: 0x1405},
+	{Group: 0x0028, Element: 0x1406},
+	{Group: 0x0028, Element: 0x1407},
+	{Group: 0x0028, Element: 0x1408},
+	{Group: 0x0028, Element: 0x140B},
+	{Group: 0x0028, Element: 0x140C},
+	{Group: 0x0028, Element: 0x140D},
+	{Group: 0x0028, Element: 0x140E},
+	{Group: 0x0028, Element: 0x140F},
+	{Group: 0x0028, Element: 0x1410},
+	{Group: 0x0028, Element: 0x2000},
+	{Group: 0x0028, Element: 0x2110},
+	{Group: 0x0028, Element: 0x2112},
+	{Group: 0x0028, Element: 0x2114},
+	{Group: 0x0028, Element: 0x3000},
+	{Group: 0x0028, Element: 0x3002},
+	{Group: 0x0028, Element: 0x3003},
+	{Group: 0x0028, Element: 0x3004},
+	{Group: 0x0028, Element: 0x3006},
+	{Group: 0x0028, Element: 0x3010},
+	{Group: 0x0028, Element: 0x3110},
+	{Group: 0x0028, Element: 0x6010},
+	{Group: 0x0028, Element: 0x6020},
+	{Group: 0x0028, Element: 0x6022},
+	{Group: 0x0028, Element: 0x6023},
+	{Group: 0x0028, Element: 0x6040},
+	{Group: 0x0028, Element: 0x6100},
+	{Group: 0x0028, Element: 0x6101},
+	{Group: 0x0028, Element: 0x6102},
+	{Group: 0x0028, Element: 0x6110},
+	{Group: 0x0028, Element: 0x6112},
+	{Group: 0x0028, Element: 0x6114},
+	{Group: 0x0028, Element: 0x6120},
+	{Group: 0x0028, Element: 0x6190},
+	{Group: 0x0028, Element: 0x7FE0},
+	{Group: 0x0028, Element: 0x9001},
+	{Group: 0x0028, Element: 0x9002},
+	{Group: 0x0028, Element: 0x9003},
+	{Group: 0x0028, Element: 0x9108},
+	{Group: 0x0028, Element: 0x9110},
+	{Group: 0x0028, Element: 0x9132},
+	{Group: 0x0028, Element: 0x9145},
+	{Group: 0x0028, Element: 0x9235},
+	{Group: 0x0028, Element: 0x9411},
+	{Group: 0x0028, Element: 0x9415},
+	{Group: 0x0028, Element: 0x9416},
+	{Group: 0x0028, Element: 0x9422},
+	{Group: 0x0028, Element: 0x9443},
+	{Group: 0x0028, Element: 0x9444},
+	{Group: 0x0028, Element: 0x9445},
+	{Group: 0x0028, Element: 0x9446},
+	{Group: 0x0028, Element: 0x9454},
+	{Group: 0x0028, Element: 0x9474},
+	{Group: 0x0028, Element: 0x9478},
+	{Group: 0x0028, Element: 0x9501},
+	{Group: 0x0028, Element: 0x9502},
+	{Group: 0x0028, Element: 0x9503},
+	{Group: 0x0028, Element: 0x9505},
+	{Group: 0x0028, Element: 0x9506},
+	{Group: 0x0028, Element: 0x9507},
+	{Group: 0x0028, Element: 0x9520},
+	{Group: 0x0028, Element: 0x9537},
+	{Group: 0x0032, Element: 0x1031},
+	{Group: 0x0032, Element: 0x1032},
+	{Group: 0x0032, Element: 0x1033},
+	{Group: 0x0032, Element: 0x1034},
+	{Group: 0x0032, Element: 0x1060},
+	{Group: 0x0032, Element: 0x1064},
+	{Group: 0x0032, Element: 0x1070},
+	{Group: 0x0038, Element: 0x0004},
+	{Group: 0x0038, Element: 0x0008},
+	{Group: 0x0038, Element: 0x0010},
+	{Group: 0x0038, Element: 0x0014},
+	{Group: 0x0038, Element: 0x0016},
+	{Group: 0x0038, Element: 0x0020},
+	{Group: 0x0038, Element: 0x0021},
+	{Group: 0x0038, Element: 0x0050},
+	{Group: 0x0038, Element: 0x0060},
+	{Group: 0x0038, Element: 0x0062},
+	{Group: 0x0038, Element: 0x0064},
+	{Group: 0x0038, Element: 0x0100},
+	{Group: 0x0038, Element: 0x0300},
+	{Group: 0x0038, Element: 0x0400},
+	{Group: 0x0038, Element: 0x0500},
+	{Group: 0x0038, Element: 0x0502},
+	{Group: 0x0038, Element: 0x4000},
+	{Group: 0x003A, Element: 0x0004},
+	{Group: 0x003A, Element: 0x0005},
+	{Group: 0x003A, Element: 0x0010},
+	{Group: 0x003A, Element: 0x001A},
+	{Group: 0x003A, Element: 0x0020},
+	{Group: 0x003A, Element: 0x0200},
+	{Group: 0x003A, Element: 0x0202},
+	{Group: 0x003A, Element: 0x0203},
+	{Group: 0x003A, Element: 0x0205},
+	{Group: 0x003A, Element: 0x0208},
+	{Group: 0x003A, Element: 0x0209},
+	{Group: 0x003A, Element: 0x020A},
+	{Group: 0x003A, Element: 0x020C},
+	{Group: 0x003A, Element: 0x0210},
+	{Group: 0x003A, Element: 0x0211},
+	{Group: 0x003A, Element: 0x0212},
+	{Group: 0x003A, Element: 0x0213},
+	{Group: 0x003A, Element: 0x0214},
+	{Group: 0x003A, Element: 0x0215},
+	{Group: 0x003A, Element: 0x0218},
+	{Group: 0x003A, Element: 0x021A},
+	{Group: 0x003A, Element: 0x0220},
+	{Group: 0x003A, Element: 0x0221},
+	{Group: 0x003A, Element: 0x0222},
+	{Group: 0x003A, Element: 0x0223},
+	{Group: 0x003A, Element: 0x0230},
+	{Group: 0x003A, Element: 0x0231},
+	{Group: 0x003A, Element: 0x0240},
+	{Group: 0x003A, Element: 0x0241},
+	{Group: 0x003A, Element: 0x0242},
+	{Group: 0x003A, Element: 0x0244},
+	{Group: 0x003A, Element: 0x0245},
+	{Group: 0x003A, Element: 0x0246},
+	{Group: 0x003A, Element: 0x0247},
+	{Group: 0x003A, Element: 0x0248},
+	{Group: 0x003A, Element: 0x0300},
+	{Group: 0x003A, Element: 0x0301},
+	{Group: 0x003A, Element: 0x0302},
+	{Group: 0x0040, Element: 0x0001},
+	{Group: 0x0040, Element: 0x0002},
+	{Group: 0x0040, Element: 0x0003},
+	{Group: 0x0040, Element: 0x0004},
+	{Group: 0x0040, Element: 0x0005},
+	{Group: 0x0040, Element: 0x0006},
+	{Group: 0x0040, Element: 0x0007},
+	{Group: 0x0040, Element: 0x0008},
+	{Group: 0x0040, Element: 0x0009},
+	{Group: 0x0040, Element: 0x000A},
+	{Group: 0x0040, Element: 0x000B},
+	{Group: 0x0040, Element: 0x0010},
+	{Group: 0x0040, Element: 0x0011},
+	{Group: 0x0040, Element: 0x0012},
+	{Group: 0x0040, Element: 0x0020},
+	{Group: 0x0040, Element: 0x0026},
+	{Group: 0x0040, Element: 0x0027},
+	{Group: 0x0040, Element: 0x0031},
+	{Group: 0x0040, Element: 0x0032},
+	{Group: 0x0040, Element: 0x0033},
+	{Group: 0x0040, Element: 0x0035},
+	{Group: 0x0040, Element: 0x0036},
+	{Group: 0x0040, Element: 0x0039},
+	{Group: 0x0040, Element: 0x003A},
+	{Group: 0x0040, Element: 0x0100},
+	{Group: 0x0040, Element: 0x0220},
+	{Group: 0x0040, Element: 0x0241},
+	{Group: 0x0040, Element: 0x0242},
+	{Group: 0x0040, Element: 0x0243},
+	{Group: 0x0040, Element: 0x0244},
+	{Group: 0x0040, Element: 0x0245},
+	{Group: 0x0040, Element: 0x0250},
+	{Group: 0x0040, Element: 0x0251},
+	{Group: 0x0040, Element: 0x0252},
+	{Group: 0x0040, Element: 0x0253},
+	{Group: 0x0040, Element: 0x0254},
+	{Group: 0x0040, Element: 0x0255},
+	{Group: 0x0040, Element: 0x0260},
+	{Group: 0x0040, Element: 0x0261},
+	{Group: 0x0040, Element: 0x0270},
+	{Group: 0x0040, Element: 0x0275},
+	{Group: 0x0040, Element: 0x0280},
+	{Group: 0x0040, Element: 0x0281},
+	{Group: 0x0040, Element: 0x0293},
+	{Group: 0x0040, Element: 0x0294},
+	{Group: 0x0040, Element: 0x0295},
+	{Group: 0x0040, Element: 0x0296},
+	{Group: 0x0040, Element: 0x0300},
+	{Group: 0x0040, Element: 0x0301},
+	{Group: 0x0040, Element: 0x0302},
+	{Group: 0x0040, Element: 0x0303},
+	{Group: 0x0040, Element: 0x0306},
+	{Group: 0x0040, Element: 0x030E},
+	{Group: 0x0040, Element: 0x0310},
+	{Group: 0x0040, Element: 0x0312},
+	{Group: 0x0040, Element: 0x0314},
+	{Group: 0x0040, Element: 0x0316},
+	{Group: 0x0040, Element: 0x0318},
+	{Group: 0x0040, Element: 0x0320},
+	{Group: 0x0040, Element: 0x0321},
+	{Group: 0x0040, Element: 0x0324},
+	{Group: 0x0040, Element: 0x0340},
+	{Group: 0x0040, Element: 0x0400},
+	{Group: 0x0040, Element: 0x0440},
+	{Group: 0x0040, Element: 0x0441},
+	{Group: 0x0040, Element: 0x0500},
+	{Group: 0x0040, Element: 0x0512},
+	{Group: 0x0040, Element: 0x0513},
+	{Group: 0x0040, Element: 0x0515},
+	{Group: 0x0040, Element: 0x0518},
+	{Group: 0x0040, Element: 0x051A},
+	{Group: 0x0040, Element: 0x0520},
+	{Group: 0x0040, Element: 0x0551},
+	{Group: 0x0040, Element: 0x0554},
+	{Group: 0x0040, Element: 0x0555},
+	{Group: 0x0040, Element: 0x0556},
+	{Group: 0x0040, Element: 0x0560},
+	{Group: 0x0040, Element: 0x0562},
+	{Group: 0x0040, Element: 0x059A},
+	{Group: 0x0040, Element: 0x0600},
+	{Group: 0x0040, Element: 0x0602},
+	{Group: 0x0040, Element: 0x0610},
+	{Group: 0x0040, Element: 0x0612},
+	{Group: 0x0040, Element: 0x0620},
+	{Group: 0x0040, Element: 0x071A},
+	{Group: 0x0040, Element: 0x072A},
+	{Group: 0x0040, Element: 0x073A},
+	{Group: 0x0040, Element: 0x074A},
+	{Group: 0x0040, Element: 0x08EA},
+	{Group: 0x0040, Element: 0x1001},
+	{Group: 0x0040, Element: 0x1002},
+	{Group: 0x0040, Element: 0x1003},
+	{Group: 0x0040, Element: 0x1004},
+	{Group: 0x0040, Element: 0x1005},
+	{Group: 0x0040, Element: 0x1008},
+	{Group: 0x0040, Element: 0x1009},
+	{Group: 0x0040, Element: 0x100A},
+	{Group: 0x0040, Element: 0x1010},
+	{Group: 0x0040, Element: 0x1011},
+	{Group: 0x0040, Element: 0x1012},
+	{Group: 0x0040, Element: 0x1101},
+	{Group: 0x0040, Element: 0x1102},
+	{Group: 0x0040, Element: 0x1103},
+	{Group: 0x0040, Element: 0x1400},
+	{Group: 0x0040, Element: 0x2004},
+	{Group: 0x0040, Element: 0x2005},
+	{Group: 0x0040, Element: 0x2008},
+	{Group: 0x0040, Element: 0x2009},
+	{Group: 0x0040, Element: 0x2010},
+	{Group: 0x0040, Element: 0x2016},
+	{Group: 0x0040, Element: 0x2017},
+	{Group: 0x0040, Element: 0x2400},
+	{Group: 0x0040, Element: 0x3001},
+	{Group: 0x0040, Element: 0x4001},
+	{Group: 0x0040, Element: 0x4002},
+	{Group: 0x0040, Element: 0x4003},
+	{Group: 0x0040, Element: 0x4004},
+	{Group: 0x0040, Element: 0x4005},
+	{Group: 0x0040, Element: 0x4006},
+	{Group: 0x0040, Element: 0x4007},
+	{Group: 0x0040, Element: 0x4009},
+	{Group: 0x0040, Element: 0x4010},
+	{Group: 0x0040, Element: 0x4011},
+	{Group: 0x0040, Element: 0x4015},
+	{Group: 0x0040, Element: 0x4016},
+	{Group: 0x0040, Element: 0x4018},
+	{Group: 0x0040, Element: 0x4019},
+	{Group: 0x0040, Element: 0x4020},
+	{Group: 0x0040, Element: 0x4021},
+	{Group: 0x0040, Element: 0x4022},
+	{Group: 0x0040, Element: 0x4023},
+	{Group: 0x0040, Element: 0x4025},
+	{Group: 0x0040, Element: 0x4026},
+	{Group: 0x0040, Element: 0x4027},
+	{Group: 0x0040, Element: 0x4028},
+	{Group: 0x0040, Element: 0x4029},
+	{Group: 0x0040, Element: 0x4030},
+	{Group: 0x0040, Element: 0x4031},
+	{Group: 0x0040, Element: 0x4032},
+	{Group: 0x0040, Element: 0x4033},
+	{Group: 0x0040, Element: 0x4034},
+	{Group: 0x0040, Element: 0x4035},
+	{Group: 0x0040, Element: 0x4036},
+	{Group: 0x0040, Element: 0x4037},
+	{Group: 0x0040, Element: 0x4040},
+	{Group: 0x0040, Element: 0x4041},
+	{Group: 0x0040, Element: 0x4050},
+	{Group: 0x0040, Element: 0x4051},
+	{Group: 0x0040, Element: 0x4052},
+	{Group: 0x0040, Element: 0x8302},
+	{Group: 0x0040, Element: 0x9094},
+	{Group: 0x0040, Element: 0x9096},
+	{Group: 0x0040, Element: 0x9098},
+	{Group: 0x0040, Element: 0x9210},
+	{Group: 0x0040, Element: 0x9211},
+	{Group: 0x0040, Element: 0x9212},
+	{Group: 0x0040, Element: 0x9216},
+	{Group: 0x0040, Element: 0x9224},
+	{Group: 0x0040, Element: 0x9225},
+	{Group: 0x0040, Element: 0xA010},
+	{Group: 0x0040, Element: 0xA027},
+	{Group: 0x0040, Element: 0xA030},
+	{Group: 0x0040, Element: 0xA032},
+	{Group: 0x0040, Element: 0xA040},
+	{Group: 0x0040, Element: 0xA043},
+	{Group: 0x0040, Element: 0xA050},
+	{Group: 0x0040, Element: 0xA073},
+	{Group: 0x0040, Element: 0xA075},
+	{Group: 0x0040, Element: 0xA078},
+	{Group: 0x0040, Element: 0xA07A},
+	{Group: 0x0040, Element: 0xA07C},
+	{Group: 0x0040, Element: 0xA080},
+	{Group: 0x0040, Element: 0xA082},
+	{Group: 0x0040, Element: 0xA084},
+	{Group: 0x0040, Element: 0xA088},
+	{Group: 0x0040, Element: 0xA0B0},
+	{Group: 0x0040, Element: 0xA120},
+	{Group: 0x0040, Element: 0xA121},
+	{Group: 0x0040, Element: 0xA122},
+	{Group: 0x0040, Element: 0xA123},
+	{Group: 0x0040, Element: 0xA124},
+	{Group: 0x0040, Element: 0xA130},
+	{Group: 0x0040, Element: 0xA132},
+	{Group: 0x0040, Element: 0xA136},
+	{Group: 0x0040, Element: 0xA138},
+	{Group: 0x0040, Element: 0xA13A},
+	{Group: 0x0040, Element: 0xA160},
+	{Group: 0x0040, Element: 0xA168},
+	{Group: 0x0040, Element: 0xA170},
+	{Group: 0x0040, Element: 0xA180},
+	{Group: 0x0040, Element: 0xA195},
+	{Group: 0x0040, Element: 0xA300},
+	{Group: 0x0040, Element: 0xA301},
+	{Group: 0x0040, Element: 0xA30A},
+	{Group: 0x0040, Element: 0xA360},
+	{Group: 0x0040, Element: 0xA370},
+	{Group: 0x0040, Element: 0xA372},
+	{Group: 0x0040, Element: 0xA375},
+	{Group: 0x0040, Element: 0xA385},
+	{Group: 0x0040, Element: 0xA390},
+	{Group: 0x0040, Element: 0xA491},
+	{Group: 0x0040, Element: 0xA492},
+	{Group: 0x0040, Element: 0xA493},
+	{Group: 0x0040, Element: 0xA494},
+	{Group: 0x0040, Element: 0xA496},
+	{Group: 0x0040, Element: 0xA504},
+	{Group: 0x0040, Element: 0xA525},
+	{Group: 0x0040, Element: 0xA730},
+	{Group: 0x0040, Element: 0xB020},
+	{Group: 0x0040, Element: 0xDB00},
+	{Group: 0x0040, Element: 0xDB73},
+	{Group: 0x0040, Element: 0xE001},
+	{Group: 0x0040, Element: 0xE004},
+	{Group: 0x0040, Element: 0xE006},
+	{Group: 0x0040, Element: 0xE008},
+	{Group: 0x0040, Element: 0xE010},
+	{Group: 0x0040, Element: 0xE011},
+	{Group: 0x0040, Element: 0xE020},
+	{Group: 0x0040, Element: 0xE021},
+	{Group: 0x0040, Element: 0xE022},
+	{Group: 0x0040, Element: 0xE023},
+	{Group: 0x0040, Element: 0xE024},
+	{Group: 0x0040, Element: 0xE030},
+	{Group: 0x0040, Element: 0xE031},
+	{Group: 0x0042, Element: 0x0010},
+	{Group: 0x0042, Element: 0x0011},
+	{Group: 0x0042, Element: 0x0012},
+	{Group: 0x0042, Element: 0x0013},
+	{Group: 0x0042, Element: 0x0014},
+	{Group: 0x0044, Element: 0x0001},
+	{Group: 0x0044, Element: 0x0002},
+	{Group: 0x0044, Element: 0x0003},
+	{Group: 0x0044, Element: 0x0004},
+	{Group: 0x0044, Element: 0x0007},
+	{Group: 0x0044, Element: 0x0008},
+	{Group: 0x0044, Element: 0x0009},
+	{Group: 0x0044, Element: 0x000A},
+	{Group: 0x0044, Element: 0x000B},
+	{Group: 0x0044, Element: 0x0010},
+	{Group: 0x0044, Element: 0x0011},
+	{Group: 0x0044, Element: 0x0012},
+	{Group: 0x0044, Element: 0x0013},
+	{Group: 0x0044, Element: 0x0019},
+	{Group: 0x0046, Element: 0x0012},
+	{Group: 0x0046, Element: 0x0014},
+	{Group: 0x0046, Element: 0x0015},
+	{Group: 0x0046, Element: 0x0016},
+	{Group: 0x0046, Element: 0x0018},
+	{Group: 0x0046, Element: 0x0028},
+	{Group: 0x0046, Element: 0x0030},
+	{Group: 0x0046, Element: 0x0032},
+	{Group: 0x0046, Element: 0x0034},
+	{Group: 0x0046, Element: 0x0036},
+	{Group: 0x0046, Element: 0x0038},
+	{Group: 0x0046, Element: 0x0040},
+	{Group: 0x0046, Element: 0x0042},
+	{Group: 0x0046, Element: 0x0044},
+	{Group: 0x0046, Element: 0x0046},
+	{Group: 0x0046, Element: 0x0050},
+	{Group: 0x0046, Element: 0x0052},
+	{Group: 0x0046, Element: 0x0060},
+	{Group: 0x0046, Element: 0x0062},
+	{Group: 0x0046, Element: 0x0063},
+	{Group: 0x0046, Element: 0x0064},
+	{Group: 0x0046, Element: 0x0070},
+	{Group: 0x0046, Element: 0x0071},
+	{Group: 0x0046, Element: 0x0074},
+	{Group: 0x0046, Element: 0x0075},
+	{Group: 0x0046, Element: 0x0076},
+	{Group: 0x0046, Element: 0x0077},
+	{Group: 0x0046, Element: 0x0080},
+	{Group: 0x0046, Element: 0x0092},
+	{Group: 0x0046, Element: 0x0094},
+	{Group: 0x0046, Element: 0x0095},
+	{Group: 0x0046, Element: 0x0097},
+	{Group: 0x0046, Element: 0x0098},
+	{Group: 0x0046, Element: 0x0100},
+	{Group: 0x0046, Element: 0x0101},
+	{Group: 0x0046, Element: 0x0102},
+	{Group: 0x0046, Element: 0x0104},
+	{Group: 0x0046, Element: 0x0106},
+	{Group: 0x0046, Element: 0x0121},
+	{Group: 0x0046, Element: 0x0122},
+	{Group: 0x0046, Element: 0x0123},
+	{Group: 0x0046, Element: 0x0124},
+	{Group: 0x0046, Element: 0x0125},
+	{Group: 0x0046, Element: 0x0135},
+	{Group: 0x0046, Element: 0x0137},
+	{Group: 0x0046, Element: 0x0139},
+	{Group: 0x0046, Element: 0x0145},
+	{Group: 0x0046, Element: 0x0146},
+	{Group: 0x0046, Element: 0x0147},
+	{Group: 0x0048, Element: 0x0001},
+	{Group: 0x0048, Element: 0x0002},
+	{Group: 0x0048, Element: 0x0003},
+	{Group: 0x0048, Element: 0x0006},
+	{Group: 0x0048, Element: 0x0007},
+	{Group: 0x0048, Element: 0x0008},
+	{Group: 0x0048, Element: 0x0010},
+	{Group: 0x0048, Element: 0x0011},
+	{Group: 0x0048, Element: 0x0012},
+	{Group: 0x0048, Element: 0x0013},
+	{Group: 0x0048, Element: 0x0014},
+	{Group: 0x0048, Element: 0x0015},
+	{Group: 0x0048, Element: 0x0100},
+	{Group: 0x0048, Element: 0x0102},
+	{Group: 0x0048, Element: 0x0105},
+	{Group: 0x0048, Element: 0x0106},
+	{Group: 0x0048, Element: 0x0107},
+	{Group: 0x0048, Element: 0x0108},
+	{Group: 0x0048, Element: 0x0110},
+	{Group: 0x0048, Element: 0x0111},
+	{Group: 0x0048, Element: 0x0112},
+	{Group: 0x0048, Element: 0x0113},
+	{Group: 0x0048, Element: 0x0120},
+	{Group: 0x0048, Element: 0x0200},
+	{Group: 0x0048, Element: 0x0201},
+	{Group: 0x0048, Element: 0x0202},
+	{Group: 0x0048, Element: 0x0207},
+	{Group: 0x0048, Element: 0x021A},
+	{Group: 0x0048, Element: 0x021E},
+	{Group: 0x0048, Element: 0x021F},
+	{Group: 0x0048, Element: 0x0301},
+	{Group: 0x0050, Element: 0x0004},
+	{Group: 0x0050, Element: 0x0010},
+	{Group: 0x0050, Element: 0x0012},
+	{Group: 0x0050, Element: 0x0013},
+	{Group: 0x0050, Element: 0x0014},
+	{Group: 0x0050, Element: 0x0015},
+	{Group: 0x0050, Element: 0x0016},
+	{Group: 0x0050, Element: 0x0017},
+	{Group: 0x0050, Element: 0x0018},
+	{Group: 0x0050, Element: 0x0019},
+	{Group: 0x0050, Element: 0x001A},
+	{Group: 0x0050, Element: 0x001B},
+	{Group: 0x0050, Element: 0x001C},
+	{Group: 0x0050, Element: 0x001D},
+	{Group: 0x0050, Element: 0x001E},
+	{Group: 0x0050, Element: 0x0020},
+	{Group: 0x0052, Element: 0x0001},
+	{Group: 0x0052, Element: 0x0002},
+	{Group: 0x0052, Element: 0x0003},
+	{Group: 0x0052, Element: 0x0004},
+	{Group: 0x0052, Element: 0x0006},
+	{Group: 0x0052, Element: 0x0007},
+	{Group: 0x0052, Element: 0x0008},
+	{Group: 0x0052, Element: 0x0009},
+	{Group: 0x0052, Element: 0x0011},
+	{Group: 0x0052, Element: 0x0012},
+	{Group: 0x0052, Element: 0x0013},
+	{Group: 0x0052, Element: 0x0014},
+	{Group: 0x0052, Element: 0x0016},
+	{Group: 0x0052, Element: 0x0025},
+	{Group: 0x0052, Element: 0x0026},
+	{Group: 0x0052, Element: 0x0027},
+	{Group: 0x0052, Element: 0x0028},
+	{Group: 0x0052, Element: 0x0029},
+	{Group: 0x0052, Element: 0x0030},
+	{Group: 0x0052, Element: 0x0031},
+	{Group: 0x0052, Element: 0x0033},
+	{Group: 0x0052, Element: 0x0034},
+	{Group: 0x0052, Element: 0x0036},
+	{Group: 0x0052, Element: 0x0038},
+	{Group: 0x0052, Element: 0x0039},
+	{Group: 0x0052, Element: 0x003A},
+	{Group: 0x0054, Element: 0x0010},
+	{Group: 0x0054, Element: 0x0011},
+	{Group: 0x0054, Element: 0x0012},
+	{Group: 0x0054, Element: 0x0013},
+	{Group: 0x0054, Element: 0x0014},
+	{Group: 0x0054, Element: 0x0015},
+	{Group: 0x0054, Element: 0x0016},
+	{Group: 0x0054, Element: 0x0017},
+	{Group: 0x0054, Element: 0x0018},
+	{Group: 0x0054, Element: 0x0020},
+	{Group: 0x0054, Element: 0x0021},
+	{Group: 0x0054, Element: 0x0022},
+	{Group: 0x0054, Element: 0x0030},
+	{Group: 0x0054, Element: 0x0031},
+	{Group: 0x0054, Element: 0x0032},
+	{Group: 0x0054, Element: 0x0033},
+	{Group: 0x0054, Element: 0x0036},
+	{Group: 0x0054, Element: 0x0038},
+	{Group: 0x0054, Element: 0x0039},
+	{Group: 0x0054, Element: 0x0050},
+	{Group: 0x0054, Element: 0x0051},
+	{Group: 0x0054, Element: 0x0052},
+	{Group: 0x0054, Element: 0x0053},
+	{Group: 0x0054, Element: 0x0060},
+	{Group: 0x0054, Element: 0x0061},
+	{Group: 0x0054, Element: 0x0062},
+	{Group: 0x0054, Element: 0x0063},
+	{Group: 0x0054, Element: 0x0070},
+	{Group: 0x0054, Element: 0x0071},
+	{Group: 0x0054, Element: 0x0072},
+	{Group: 0x0054, Element: 0x0073},
+	{Group: 0x0054, Element: 0x0080},
+	{Group: 0x0054, Element: 0x0081},
+	{Group: 0x0054, Element: 0x0090},
+	{Group: 0x0054, Element: 0x0100},
+	{Group: 0x0054, Element: 0x0101},
+	{Group: 0x0054, Element: 0x0200},
+	{Group: 0x0054, Element: 0x0202},
+	{Group: 0x0054, Element: 0x0210},
+	{Group: 0x0054, Element: 0x0211},
+	{Group: 0x0054, Element: 0x0220},
+	{Group: 0x0054, Element: 0x0222},
+	{Group: 0x0054, Element: 0x0300},
+	{Group: 0x0054, Element: 0x0302},
+	{Group: 0x0054, Element: 0x0304},
+	{Group: 0x0054, Element: 0x0306},
+	{Group: 0x0054, Element: 0x0308},
+	{Group: 0x0054, Element: 0x0400},
+	{Group: 0x0054, Element: 0x0410},
+	{Group: 0x0054, Element: 0x0412},
+	{Group: 0x0054, Element: 0x0414},
+	{Group: 0x0054, Element: 0x0500},
+	{Group: 0x0054, Element: 0x1000},
+	{Group: 0x0054, Element: 0x1001},
+	{Group: 0x0054, Element: 0x1002},
+	{Group: 0x0054, Element: 0x1004},
+	{Group: 0x0054, Element: 0x1006},
+	{Group: 0x0054, Element: 0x1100},
+	{Group: 0x0054, Element: 0x1101},
+	{Group: 0x0054, Element: 0x1102},
+	{Group: 0x0054, Element: 0x1103},
+	{Group: 0x0054, Element: 0x1104},
+	{Group: 0x0054, Element: 0x1105},
+	{Group: 0x0054, Element: 0x1200},
+	{Group: 0x0054, Element: 0x1201},
+	{Group: 0x0054, Element: 0x1202},
+	{Group: 0x0054, Element: 0x1203},
+	{Group: 0x0054, Element: 0x1210},
+	{Group: 0x0054, Element: 0x1220},
+	{Group: 0x0054, Element: 0x1300},
+	{Group: 0x0054, Element: 0x1310},
+	{Group: 0x0054, Element: 0x1311},
+	{Group: 0x0054, Element: 0x1320},
+	{Group: 0x0054, Element: 0x1321},
+	{Group: 0x0054, Element: 0x1322},
+	{Group: 0x0054, Element: 0x1323},
+	{Group: 0x0054, Element: 0x1324},
+	{Group: 0x0054, Element: 0x1330},
+	{Group: 0x0060, Element: 0x3000},
+	{Group: 0x0060, Element: 0x3002},
+	{Group: 0x0060, Element: 0x3004},
+	{Group: 0x0060, Element: 0x3006},
+	{Group: 0x0060, Element: 0x3008},
+	{Group: 0x0060, Element: 0x3010},
+	{Group: 0x0060, Element: 0x3020},
+	{Group: 0x0062, Element: 0x0001},
+	{Group: 0x0062, Element: 0x0002},
+	{Group: 0x0062, Element: 0x0003},
+	{Group: 0x0062, Element: 0x0004},
+	{Group: 0x0062, Element: 0x0005},
+	{Group: 0x0062, Element: 0x0006},
+	{Group: 0x0062, Element: 0x0008},
+	{Group: 0x0062, Element: 0x0009},
+	{Group: 0x0062, Element: 0x000A},
+	{Group: 0x0062, Element: 0x000B},
+	{Group: 0x0062, Element: 0x000C},
+	{Group: 0x0062, Element: 0x000D},
+	{Group: 0x0062, Element: 0x000E},
+	{Group: 0x0062, Element: 0x000F},
+	{Group: 0x0062, Element: 0x0010},
+	{Group: 0x0064, Element: 0x0002},
+	{Group: 0x0064, Element: 0x0003},
+	{Group: 0x0064, Element: 0x0005},
+	{Group: 0x0064, Element: 0x0007},
+	{Group: 0x0064, Element: 0x0008},
+	{Group: 0x0064, Element: 0x0009},
+	{Group: 0x0064, Element: 0x000F},
+	{Group: 0x0064, Element: 0x0010},
+	{Group: 0x0066, Element: 0x0001},
+	{Group: 0x0066, Element: 0x0002},
+	{Group: 0x0066, Element: 0x0003},
+	{Group: 0x0066, Element: 0x0004},
+	{Group: 0x0066, Element: 0x0009},
+	{Group: 0x0066, Element: 0x000A},
+	{Group: 0x0066, Element: 0x000B},
+	{Group: 0x0066, Element: 0x000C},
+	{Group: 0x0066, Element: 0x000D},
+	{Group: 0x0066, Element: 0x000E},
+	{Group: 0x0066, Element: 0x0010},
+	{Group: 0x0066, Element: 0x0011},
+	{Group: 0x0066, Element: 0x0012},
+	{Group: 0x0066, Element: 0x0013},
+	{Group: 0x0066, Element: 0x0015},
+	{Group: 0x0066, Element: 0x0016},
+	{Group: 0x0066, Element: 0x0017},
+	{Group: 0x0066, Element: 0x0018},
+	{Group: 0x0066, Element: 0x0019},
+	{Group: 0x0066, Element: 0x001A},
+	{Group: 0x0066, Element: 0x001B},
+	{Group: 0x0066, Element: 0x001C},
+	{Group: 0x0066, Element: 0x001E},
+	{Group: 0x0066, Element: 0x001F},
+	{Group: 0x0066, Element: 0x0020},
+	{Group: 0x0066, Element: 0x0021},
+	{Group: 0x0066, Element: 0x0023},
+	{Group: 0x0066, Element: 0x0024},
+	{Group: 0x0066, Element: 0x0025},
+	{Group: 0x0066, Element: 0x0026},
+	{Group: 0x0066, Element: 0x0027},
+	{Group: 0x0066, Element: 0x0028},
+	{Group: 0x0066, Element: 0x0029},
+	{Group: 0x0066, Element: 0x002A},
+	{Group: 0x0066, Element: 0x002B},
+	{Group: 0x0066, Element: 0x002C},
+	{Group: 0x0066, Element: 0x002D},
+	{Group: 0x0066, Element: 0x002E},
+	{Group: 0x0066, Element: 0x002F},
+	{Group: 0x0066, Element: 0x0030},
+	{Group: 0x0066, Element: 0x0031},
+	{Group: 0x0066, Element: 0x0032},
+	{Group: 0x0066, Element: 0x0034},
+	{Group: 0x0066, Element: 0x0035},
+	{Group: 0x0066, Element: 0x0036},
+	{Group: 0x0068, Element: 0x6210},
+	{Group: 0x0068, Element: 0x6221},
+	{Group: 0x0068, Element: 0x6222},
+	{Group: 0x0068, Element: 0x6223},
+	{Group: 0x0068, Element: 0x6224},
+	{Group: 0x0068, Element: 0x6225},
+	{Group: 0x0068, Element: 0x6226},
+	{Group: 0x0068, Element: 0x6230},
+	{Group: 0x0068, Element: 0x6260},
+	{Group: 0x0068, Element: 0x6265},
+	{Group: 0x0068, Element: 0x6270},
+	{Group: 0x0068, Element: 0x6280},
+	{Group: 0x0068, Element: 0x62A0},
+	{Group: 0x0068, Element: 0x62A5},
+	{Group: 0x0068, Element: 0x62C0},
+	{Group: 0x0068, Element: 0x62D0},
+	{Group: 0x0068, Element: 0x62D5},
+	{Group: 0x0068, Element: 0x62E0},
+	{Group: 0x0068, Element: 0x62F0},
+	{Group: 0x0068, Element: 0x62F2},
+	{Group: 0x0068, Element: 0x6300},
+	{Group: 0x0068, Element: 0x6310},
+	{Group: 0x0068, Element: 0x6320},
+	{Group: 0x0068, Element: 0x6330},
+	{Group: 0x0068, Element: 0x6340},
+	{Group: 0x0068, Element: 0x6345},
+	{Group: 0x0068, Element: 0x6346},
+	{Group: 0x0068, Element: 0x6347},
+	{Group: 0x0068, Element: 0x6350},
+	{Group: 0x0068, Element: 0x6360},
+	{Group: 0x0068, Element: 0x6380},
+	{Group: 0x0068, Element: 0x6390},
+	{Group: 0x0068, Element: 0x63A0},
+	{Group: 0x0068, Element: 0x63A4},
+	{Group: 0x0068, Element: 0x63A8},
+	{Group: 0x0068, Element: 0x63AC},
+	{Group: 0x0068, Element: 0x63B0},
+	{Group: 0x0068, Element: 0x63C0},
+	{Group: 0x0068, Element: 0x63D0},
+	{Group: 0x0068, Element: 0x63E0},
+	{Group: 0x0068, Element: 0x63F0},
+	{Group: 0x0068, Element: 0x6400},
+	{Group: 0x0068, Element: 0x6410},
+	{Group: 0x0068, Element: 0x6420},
+	{Group: 0x0068, Element: 0x6430},
+	{Group: 0x0068, Element: 0x6440},
+	{Group: 0x0068, Element: 0x6450},
+	{Group: 0x0068, Element: 0x6460},
+	{Group: 0x0068, Element: 0x6470},
+	{Group: 0x0068, Element: 0x6490},
+	{Group: 0x0068, Element: 0x64A0},
+	{Group: 0x0068, Element: 0x64C0},
+	{Group: 0x0068, Element: 0x64D0},
+	{Group: 0x0068, Element: 0x64F0},
+	{Group: 0x0068, Element: 0x6500},
+	{Group: 0x0068, Element: 0x6510},
+	{Group: 0x0068, Element: 0x6520},
+	{Group: 0x0068, Element: 0x6530},
+	{Group: 0x0068, Element: 0x6540},
+	{Group: 0x0068, Element: 0x6545},
+	{Group: 0x0068, Element: 0x6550},
+	{Group: 0x0068, Element: 0x6560},
+	{Group: 0x0068, Element: 0x6590},
+	{Group: 0x0068, Element: 0x65A0},
+	{Group: 0x0068, Element: 0x65B0},
+	{Group: 0x0068, Element: 0x65D0},
+	{Group: 0x0068, Element: 0x65E0},
+	{Group: 0x0068, Element: 0x65F0},
+	{Group: 0x0068, Element: 0x6610},
+	{Group: 0x0068, Element: 0x6620},
+	{Group: 0x0070, Element: 0x0001},
+	{Group: 0x0070, Element: 0x0002},
+	{Group: 0x0070, Element: 0x0003},
+	{Group: 0x0070, Element: 0x0004},
+	{Group: 0x0070, Element: 0x0005},
+	{Group: 0x0070, Element: 0x0006},
+	{Group: 0x0070, Element: 0x0008},
+	{Group: 0x0070, Element: 0x0009},
+	{Group: 0x0070, Element: 0x0010},
+	{Group: 0x0070, Element: 0x0011},
+	{Group: 0x0070, Element: 0x0012},
+	{Group: 0x0070, Element: 0x0014},
+	{Group: 0x0070, Element: 0x0015},
+	{Group: 0x0070, Element: 0x0020},
+	{Group: 0x0070, Element: 0x0021},
+	{Group: 0x0070, Element: 0x0022},
+	{Group: 0x0070, Element: 0x0023},
+	{Group: 0x0070, Element: 0x0024},
+	{Group: 0x0070, Element: 0x0041},
+	{Group: 0x0070, Element: 0x0042},
+	{Group: 0x0070, Element: 0x0052},
+	{Group: 0x0070, Element: 0x0053},
+	{Group: 0x0070, Element: 0x005A},
+	{Group: 0x0070, Element: 0x0060},
+	{Group: 0x0070, Element: 0x0062},
+	{Group: 0x0070, Element: 0x0066},
+	{Group: 0x0070, Element: 0x0068},
+	{Group: 0x0070, Element: 0x0080},
+	{Group: 0x0070, Element: 0x0081},
+	{Group: 0x0070, Element: 0x0082},
+	{Group: 0x0070, Element: 0x0083},
+	{Group: 0x0070, Element: 0x0084},
+	{Group: 0x0070, Element: 0x0086},
+	{Group: 0x0070, Element: 0x0087},
+	{Group: 0x0070, Element: 0x0100},
+	{Group: 0x0070, Element: 0x0101},
+	{Group: 0x0070, Element: 0x0102},
+	{Group: 0x0070, Element: 0x0103},
+	{Group: 0x0070, Element: 0x0207},
+	{Group: 0x0070, Element: 0x0208},
+	{Group: 0x0070, Element: 0x0209},
+	{Group: 0x0070, Element: 0x0226},
+	{Group: 0x0070, Element: 0x0227},
+	{Group: 0x0070, Element: 0x0228},
+	{Group: 0x0070, Element: 0x0229},
+	{Group: 0x0070, Element: 0x0230},
+	{Group: 0x0070, Element: 0x0231},
+	{Group: 0x0070, Element: 0x0232},
+	{Group: 0x0070, Element: 0x0233},
+	{Group: 0x0070, Element: 0x0234},
+	{Group: 0x0070, Element: 0x0241},
+	{Group: 0x0070, Element: 0x0242},
+	{Group: 0x0070, Element: 0x0243},
+	{Group: 0x0070, Element: 0x0244},
+	{Group: 0x0070, Element: 0x0245},
+	{Group: 0x0070, Element: 0x0246},
+	{Group: 0x0070, Element: 0x0247},
+	{Group: 0x0070, Element: 0x0248},
+	{Group: 0x0070, Element: 0x0249},
+	{Group: 0x0070, Element: 0x0250},
+	{Group: 0x0070, Element: 0x0251},
+	{Group: 0x0070, Element: 0x0252},
+	{Group: 0x0070, Element: 0x0253},
+	{Group: 0x0070, Element: 0x0254},
+	{Group: 0x0070, Element: 0x0255},
+	{Group: 0x0070, Element: 0x0256},
+	{Group: 0x0070, Element: 0x0257},
+	{Group: 0x0070, Element: 0x0258},
+	{Group: 0x0070, Element: 0x0261},
+	{Group: 0x0070, Element: 0x0262},
+	{Group: 0x0070, Element: 0x0273},
+	{Group: 0x0070, Element: 0x0274},
+	{Group: 0x0070, Element: 0x0278},
+	{Group: 0x0070, Element: 0x0279},
+	{Group: 0x0070, Element: 0x0282},
+	{Group: 0x0070, Element: 0x0284},
+	{Group: 0x0070, Element: 0x0285},
+	{Group: 0x0070, Element: 0x0287},
+	{Group: 0x0070, Element: 0x0288},
+	{Group: 0x0070, Element: 0x0289},
+	{Group: 0x0070, Element: 0x0294},
+	{Group: 0x0070, Element: 0x0295},
+	{Group: 0x0070, Element: 0x0306},
+	{Group: 0x0070, Element: 0x0308},
+	{Group: 0x0070, Element: 0x0309},
+	{Group: 0x0070, Element: 0x030A},
+	{Group: 0x0070, Element: 0x030C},
+	{Group: 0x0070, Element: 0x030D},
+	{Group: 0x0070, Element: 0x030F},
+	{Group: 0x0070, Element: 0x0310},
+	{Group: 0x0070, Element: 0x0311},
+	{Group: 0x0070, Element: 0x0312},
+	{Group: 0x0070, Element: 0x0314},
+	{Group: 0x0070, Element: 0x0318},
+	{Group: 0x0070, Element: 0x031A},
+	{Group: 0x0070, Element: 0x031C},
+	{Group: 0x0070, Element: 0x031E},
+	{Group: 0x0070, Element: 0x0401},
+	{Group: 0x0070, Element: 0x0402},
+	{Group: 0x0070, Element: 0x0403},
+	{Group: 0x0070, Element: 0x0404},
+	{Group: 0x0070, Element: 0x0405},
+	{Group: 0x0072, Element: 0x0002},
+	{Group: 0x0072, Element: 0x0004},
+	{Group: 0x0072, Element: 0x0006},
+	{Group: 0x0072, Element: 0x0008},
+	{Group: 0x0072, Element: 0x000A},
+	{Group: 0x0072, Element: 0x000C},
+	{Group: 0x0072, Element: 0x000E},
+	{Group: 0x0072, Element: 0x0010},
+	{Group: 0x0072, Element: 0x0012},
+	{Group: 0x0072, Element: 0x0014},
+	{Group: 0x0072, Element: 0x0020},
+	{Group: 0x0072, Element: 0x0022},
+	{Group: 0x0072, Element: 0x0024},
+	{Group: 0x0072, Element: 0x0026},
+	{Group: 0x0072, Element: 0x0028},
+	{Group: 0x0072, Element: 0x0030},
+	{Group: 0x0072, Element: 0x0032},
+	{Group: 0x0072, Element: 0x0034},
+	{Group: 0x0072, Element: 0x0038},
+	{Group: 0x0072, Element: 0x003A},
+	{Group: 0x0072, Element: 0x003C},
+	{Group: 0x0072, Element: 0x003E},
+	{Group: 0x0072, Element: 0x0040},
+	{Group: 0x0072, Element: 0x0050},
+	{Group: 0x0072, Element: 0x0052},
+	{Group: 0x0072, Element: 0x0054},
+	{Group: 0x0072, Element: 0x0056},
+	{Group: 0x0072, Element: 0x0060},
+	{Group: 0x0072, Element: 0x0062},
+	{Group: 0x0072, Element: 0x0064},
+	{Group: 0x0072, Element: 0x0066},
+	{Group: 0x0072, Element: 0x0068},
+	{Group: 0x0072, Element: 0x006A},
+	{Group: 0x0072, Element: 0x006C},
+	{Group: 0x0072, Element: 0x006E},
+	{Group: 0x0072, Element: 0x0070},
+	{Group: 0x0072, Element: 0x0072},
+	{Group: 0x0072, Element: 0x0074},
+	{Group: 0x0072, Element: 0x0076},
+	{Group: 0x0072, Element: 0x0078},
+	{Group: 0x0072, Element: 0x007A},
+	{Group: 0x0072, Element: 0x007C},
+	{Group: 0x0072, Element: 0x007E},
+	{Group: 0x0072, Element: 0x0080},
+	{Group: 0x0072, Element: 0x0100},
+	{Group: 0x0072, Element: 0x0102},
+	{Group: 0x0072, Element: 0x0104},
+	{Group: 0x0072, Element: 0x0106},
+	{Group: 0x0072, Element: 0x0108},
+	{Group: 0x0072, Element: 0x010A},
+	{Group: 0x0072, Element: 0x010C},
+	{Group: 0x0072, Element: 0x010E},
+	{Group: 0x0072, Element: 0x0200},
+	{Group: 0x0072, Element: 0x0202},
+	{Group: 0x0072, Element: 0x0203},
+	{Group: 0x0072, Element: 0x0204},
+	{Group: 0x0072, Element: 0x0206},
+	{Group: 0x0072, Element: 0x0208},
+	{Group: 0x0072, Element: 0x0210},
+	{Group: 0x0072, Element: 0x0212},
+	{Group: 0x0072, Element: 0x0214},
+	{Group: 0x0072, Element: 0x0216},
+	{Group: 0x0072, Element: 0x0218},
+	{Group: 0x0072, Element: 0x0300},
+	{Group: 0x0072, Element: 0x0302},
+	{Group: 0x0072, Element: 0x0304},
+	{Group: 0x0072, Element: 0x0306},
+	{Group: 0x0072, Element: 0x0308},
+	{Group: 0x0072, Element: 0x0310},
+	{Group: 0x0072, Element: 0x0312},
+	{Group: 0x0072, Element: 0x0314},
+	{Group: 0x0072, Element: 0x0316},
+	{Group: 0x0072, Element: 0x0318},
+	{Group: 0x0072, Element: 0x0320},
+	{Group: 0x0072, Element: 0x0330},
+	{Group: 0x0072, Element: 0x0400},
+	{Group: 0x0072, Element: 0x0402},
+	{Group: 0x0072, Element: 0x0404},
+	{Group: 0x0072, Element: 0x0406},
+	{Group: 0x0072, Element: 0x0420},
+	{Group: 0x0072, Element: 0x0421},
+	{Group: 0x0072, Element: 0x0422},
+	{Group: 0x0072, Element: 0x0424},
+	{Group: 0x0072, Element: 0x0427},
+	{Group: 0x0072, Element: 0x0430},
+	{Group: 0x0072, Element: 0x0432},
+	{Group: 0x0072, Element: 0x0434},
+	{Group: 0x0072, Element: 0x0500},
+	{Group: 0x0072, Element: 0x0510},
+	{Group: 0x0072, Element: 0x0512},
+	{Group: 0x0072, Element: 0x0514},
+	{Group: 0x0072, Element: 0x0516},
+	{Group: 0x0072, Element: 0x0520},
+	{Group: 0x0072, Element: 0x0600},
+	{Group: 0x0072, Element: 0x0602},
+	{Group: 0x0072, Element: 0x0604},
+	{Group: 0x0072, Element: 0x0700},
+	{Group: 0x0072, Element: 0x0702},
+	{Group: 0x0072, Element: 0x0704},
+	{Group: 0x0072, Element: 0x0705},
+	{Group: 0x0072, Element: 0x0706},
+	{Group: 0x0072, Element: 0x0710},
+	{Group: 0x0072, Element: 0x0712},
+	{Group: 0x0072, Element: 0x0714},
+	{Group: 0x0072, Element: 0x0716},
+	{Group: 0x0072, Element: 0x0717},
+	{Group: 0x0072, Element: 0x0718},
+	{Group: 0x0074, Element: 0x0120},
+	{Group: 0x0074, Element: 0x0121},
+	{Group: 0x0074, Element: 0x1000},
+	{Group: 0x0074, Element: 0x1002},
+	{Group: 0x0074, Element: 0x1004},
+	{Group: 0x0074, Element: 0x1006},
+	{Group: 0x0074, Element: 0x1008},
+	{Group: 0x0074, Element: 0x100A},
+	{Group: 0x0074, Element: 0x100C},
+	{Group: 0x0074, Element: 0x100E},
+	{Group: 0x0074, Element: 0x1020},
+	{Group: 0x0074, Element: 0x1022},
+	{Group: 0x0074, Element: 0x1026},
+	{Group: 0x0074, Element: 0x1027},
+	{Group: 0x0074, Element: 0x1028},
+	{Group: 0x0074, Element: 0x102A},
+	{Group: 0x0074, Element: 0x102B},
+	{Group: 0x0074, Element: 0x102C},
+	{Group: 0x0074, Element: 0x102D},
+	{Group: 0x0074, Element: 0x1030},
+	{Group: 0x0074, Element: 0x1032},
+	{Group: 0x0074, Element: 0x1034},
+	{Group: 0x0074, Element: 0x1036},
+	{Group: 0x0074, Element: 0x1040},
+	{Group: 0x0074, Element: 0x1042},
+	{Group: 0x0074, Element: 0x1044},
+	{Group: 0x0074, Element: 0x1046},
+	{Group: 0x0074, Element: 0x1048},
+	{Group: 0x0074, Element: 0x104A},
+	{Group: 0x0074, Element: 0x104C},
+	{Group: 0x0074, Element: 0x104E},
+	{Group: 0x0074, Element: 0x1050},
+	{Group: 0x0074, Element: 0x1052},
+	{Group: 0x0074, Element: 0x1054},
+	{Group: 0x0074, Element: 0x1056},
+	{Group: 0x0074, Element: 0x1057},
+	{Group: 0x0074, Element: 0x1200},
+	{Group: 0x0074, Element: 0x1202},
+	{Group: 0x0074, Element: 0x1204},
+	{Group: 0x0074, Element: 0x1210},
+	{Group: 0x0074, Element: 0x1212},
+	{Group: 0x0074, Element: 0x1216},
+	{Group: 0x0074, Element: 0x1224},
+	{Group: 0x0074, Element: 0x1230},
+	{Group: 0x0074, Element: 0x1234},
+	{Group: 0x0074, Element: 0x1236},
+	{Group: 0x0074, Element: 0x1238},
+	{Group: 0x0074, Element: 0x1242},
+	{Group: 0x0074, Element: 0x1244},
+	{Group: 0x0074, Element: 0x1246},
+	{Group: 0x0074, Element: 0x1324},
+	{Group: 0x0074, Element: 0x1338},
+	{Group: 0x0074, Element: 0x133A},
+	{Group: 0x0076, Element: 0x0001},
+	{Group: 0x0076, Element: 0x0003},
+	{Group: 0x0076, Element: 0x0006},
+	{Group: 0x0076, Element: 0x0008},
+	{Group: 0x0076, Element: 0x000A},
+	{Group: 0x0076, Element: 0x000C},
+	{Group: 0x0076, Element: 0x000E},
+	{Group: 0x0076, Element: 0x0010},
+	{Group: 0x0076, Element: 0x0020},
+	{Group: 0x0076, Element: 0x0030},
+	{Group: 0x0076, Element: 0x0032},
+	{Group: 0x0076, Element: 0x0034},
+	{Group: 0x0076, Element: 0x0036},
+	{Group: 0x0076, Element: 0x0038},
+	{Group: 0x0076, Element: 0x0040},
+	{Group: 0x0076, Element: 0x0055},
+	{Group: 0x0076, Element: 0x0060},
+	{Group: 0x0076, Element: 0x0070},
+	{Group: 0x0076, Element: 0x0080},
+	{Group: 0x0076, Element: 0x0090},
+	{Group: 0x0076, Element: 0x00A0},
+	{Group: 0x0076, Element: 0x00B0},
+	{Group: 0x0076, Element: 0x00C0},
+	{Group: 0x0078, Element: 0x0001},
+	{Group: 0x0078, Element: 0x0010},
+	{Group: 0x0078, Element: 0x0020},
+	{Group: 0x0078, Element: 0x0024},
+	{Group: 0x0078, Element: 0x0026},
+	{Group: 0x0078, Element: 0x0028},
+	{Group: 0x0078, Element: 0x002A},
+	{Group: 0x0078, Element: 0x002E},
+	{Group: 0x0078, Element: 0x0050},
+	{Group: 0x0078, Element: 0x0060},
+	{Group: 0x0078, Element: 0x0070},
+	{Group: 0x0078, Element: 0x0090},
+	{Group: 0x0078, Element: 0x00A0},
+	{Group: 0x0078, Element: 0x00B0},
+	{Group: 0x0078, Element: 0x00B2},
+	{Group: 0x0078, Element: 0x00B4},
+	{Group: 0x0078, Element: 0x00B6},
+	{Group: 0x0078, Element: 0x00B8},
+	{Group: 0x0088, Element: 0x0130},
+	{Group: 0x0088, Element: 0x0140},
+	{Group: 0x0088, Element: 0x0200},
+	{Group: 0x0100, Element: 0x0410},
+	{Group: 0x0100, Element: 0x0420},
+	{Group: 0x0100, Element: 0x0424},
+	{Group: 0x0100, Element: 0x0426},
+	{Group: 0x0400, Element: 0x0005},
+	{Group: 0x0400, Element: 0x0010},
+	{Group: 0x0400, Element: 0x0015},
+	{Group: 0x0400, Element: 0x0020},
+	{Group: 0x0400, Element: 0x0100},
+	{Group: 0x0400, Element: 0x0105},
+	{Group: 0x0400, Element: 0x0110},
+	{Group: 0x0400, Element: 0x0115},
+	{Group: 0x0400, Element: 0x0120},
+	{Group: 0x0400, Element: 0x0305},
+	{Group: 0x0400, Element: 0x0310},
+	{Group: 0x0400, Element: 0x0401},
+	{Group: 0x0400, Element: 0x0402},
+	{Group: 0x0400, Element: 0x0403},
+	{Group: 0x0400, Element: 0x0404},
+	{Group: 0x0400, Element: 0x0500},
+	{Group: 0x0400, Element: 0x0510},
+	{Group: 0x0400, Element: 0x0520},
+	{Group: 0x0400, Element: 0x0550},
+	{Group: 0x0400, Element: 0x0561},
+	{Group: 0x0400, Element: 0x0562},
+	{Group: 0x0400, Element: 0x0563},
+	{Group: 0x0400, Element: 0x0564},
+	{Group: 0x0400, Element: 0x0565},
+	{Group: 0x2000, Element: 0x0010},
+	{Group: 0x2000, Element: 0x001E},
+	{Group: 0x2000, Element: 0x0020},
+	{Group: 0x2000, Element: 0x0030},
+	{Group: 0x2000, Element: 0x0040},
+	{Group: 0x2000, Element: 0x0050},
+	{Group: 0x2000, Element: 0x0060},
+	{Group: 0x2000, Element: 0x0061},
+	{Group: 0x2000, Element: 0x00A0},
+	{Group: 0x2000, Element: 0x00A1},
+	{Group: 0x2000, Element: 0x00A2},
+	{Group: 0x2000, Element: 0x00A4},
+	{Group: 0x2000, Element: 0x00A8},
+	{Group: 0x2000, Element: 0x0500},
+	{Group: 0x2010, Element: 0x0010},
+	{Group: 0x2010, Element: 0x0030},
+	{Group: 0x2010, Element: 0x0040},
+	{Group: 0x2010, Element: 0x0050},
+	{Group: 0x2010, Element: 0x0052},
+	{Group: 0x2010, Element: 0x0054},
+	{Group: 0x2010, Element: 0x0060},
+	{Group: 0x2010, Element: 0x0080},
+	{Group: 0x2010, Element: 0x00A6},
+	{Group: 0x2010, Element: 0x00A7},
+	{Group: 0x2010, Element: 0x00A8},
+	{Group: 0x2010, Element: 0x00A9},
+	{Group: 0x2010, Element: 0x0100},
+	{Group: 0x2010, Element: 0x0110},
+	{Group: 0x2010, Element: 0x0120},
+	{Group: 0x2010, Element: 0x0130},
+	{Group: 0x2010, Element: 0x0140},
+	{Group: 0x2010, Element: 0x0150},
+	{Group: 0x2010, Element: 0x0152},
+	{Group: 0x2010, Element: 0x0154},
+	{Group: 0x2010, Element: 0x015E},
+	{Group: 0x2010, Element: 0x0160},
+	{Group: 0x2010, Element: 0x0376},
+	{Group: 0x2010, Element: 0x0500},
+	{Group: 0x2010, Element: 0x0510},
+	{Group: 0x2010, Element: 0x0520},
+	{Group: 0x2020, Element: 0x0010},
+	{Group: 0x2020, Element: 0x0020},
+	{Group: 0x2020, Element: 0x0030},
+	{Group: 0x2020, Element: 0x0040},
+	{Group: 0x2020, Element: 0x0050},
+	{Group: 0x2020, Element: 0x00A0},
+	{Group: 0x2020, Element: 0x00A2},
+	{Group: 0x2020, Element: 0x0110},
+	{Group: 0x2020, Element: 0x0111},
+	{Group: 0x2030, Element: 0x0010},
+	{Group: 0x2030, Element: 0x0020},
+	{Group: 0x2050, Element: 0x0010},
+	{Group: 0x2050, Element: 0x0020},
+	{Group: 0x2050, Element: 0x0500},
+	{Group: 0x2100, Element: 0x0020},
+	{Group: 0x2100, Element: 0x0030},
+	{Group: 0x2100, Element: 0x0040},
+	{Group: 0x2100, Element: 0x0050},
+	{Group: 0x2100, Element: 0x0070},
+	{Group: 0x2100, Element: 0x0160},
+	{Group: 0x2100, Element: 0x0170},
+	{Group: 0x2110, Element: 0x0010},
+	{Group: 0x2110, Element: 0x0020},
+	{Group: 0x2110, Element: 0x0030},
+	{Group: 0x2200, Element: 0x0001},
+	{Group: 0x2200, Element: 0x0002},
+	{Group: 0x2200, Element: 0x0003},
+	{Group: 0x2200, Element: 0x0004},
+	{Group: 0x2200, Element: 0x0005},
+	{Group: 0x2200, Element: 0x0006},
+	{Group: 0x2200, Element: 0x0007},
+	{Group: 0x2200, Element: 0x0008},
+	{Group: 0x2200, Element: 0x0009},
+	{Group: 0x2200, Element: 0x000A},
+	{Group: 0x2200, Element: 0x000B},
+	{Group: 0x2200, Element: 0x000C},
+	{Group: 0x2200, Element: 0x000D},
+	{Group: 0x2200, Element: 0x000E},
+	{Group: 0x2200, Element: 0x000F},
+	{Group: 0x2200, Element: 0x0020},
+	{Group: 0x3002, Element: 0x0002},
+	{Group: 0x3002, Element: 0x0003},
+	{Group: 0x3002, Element: 0x0004},
+	{Group: 0x3002, Element: 0x000A},
+	{Group: 0x3002, Element: 0x000C},
+	{Group: 0x3002, Element: 0x000D},
+	{Group: 0x3002, Element: 0x000E},
+	{Group: 0x3002, Element: 0x0010},
+	{Group: 0x3002, Element: 0x0011},
+	{Group: 0x3002, Element: 0x0012},
+	{Group: 0x3002, Element: 0x0020},
+	{Group: 0x3002, Element: 0x0022},
+	{Group: 0x3002, Element: 0x0024},
+	{Group: 0x3002, Element: 0x0026},
+	{Group: 0x3002, Element: 0x0028},
+	{Group: 0x3002, Element: 0x0029},
+	{Group: 0x3002, Element: 0x0030},
+	{Group: 0x3002, Element: 0x0032},
+	{Group: 0x3002, Element: 0x0034},
+	{Group: 0x3002, Element: 0x0040},
+	{Group: 0x3002, Element: 0x0041},
+	{Group: 0x3002, Element: 0x0042},
+	{Group: 0x3002, Element: 0x0050},
+	{Group: 0x3002, Element: 0x0051},
+	{Group: 0x3002, Element: 0x0052},
+	{Group: 0x3004, Element: 0x0001},
+	{Group: 0x3004, Element: 0x0002},
+	{Group: 0x3004, Element: 0x0004},
+	{Group: 0x3004, Element: 0x0006},
+	{Group: 0x3004, Element: 0x0008},
+	{Group: 0x3004, Element: 0x000A},
+	{Group: 0x3004, Element: 0x000C},
+	{Group: 0x3004, Element: 0x000E},
+	{Group: 0x3004, Element: 0x0010},
+	{Group: 0x3004, Element: 0x0012},
+	{Group: 0x3004, Element: 0x0014},
+	{Group: 0x3004, Element: 0x0040},
+	{Group: 0x3004, Element: 0x0042},
+	{Group: 0x3004, Element: 0x0050},
+	{Group: 0x3004, Element: 0x0052},
+	{Group: 0x3004, Element: 0x0054},
+	{Group: 0x3004, Element: 0x0056},
+	{Group: 0x3004, Element: 0x0058},
+	{Group: 0x3004, Element: 0x0060},
+	{Group: 0x3004, Element: 0x0062},
+	{Group: 0x3004, Element: 0x0070},
+	{Group: 0x3004, Element: 0x0072},
+	{Group: 0x3004, Element: 0x0074},
+	{Group: 0x3006, Element: 0x0002},
+	{Group: 0x3006, Element: 0x0004},
+	{Group: 0x3006, Element: 0x0006},
+	{Group: 0x3006, Element: 0x0008},
+	{Group: 0x3006, Element: 0x0009},
+	{Group: 0x3006, Element: 0x0010},
+	{Group: 0x3006, Element: 0x0012},
+	{Group: 0x3006, Element: 0x0014},
+	{Group: 0x3006, Element: 0x0016},
+	{Group: 0x3006, Element: 0x0020},
+	{Group: 0x3006, Element: 0x0022},
+	{Group: 0x3006, Element: 0x0024},
+	{Group: 0x3006, Element: 0x0026},
+	{Group: 0x3006, Element: 0x0028},
+	{Group: 0x3006, Element: 0x002A},
+	{Group: 0x3006, Element: 0x002C},
+	{Group: 0x3006, Element: 0x0030},
+	{Group: 0x3006, Element: 0x0033},
+	{Group: 0x3006, Element: 0x0036},
+	{Group: 0x3006, Element: 0x0038},
+	{Group: 0x3006, Element: 0x0039},
+	{Group: 0x3006, Element: 0x0040},
+	{Group: 0x3006, Element: 0x0042},
+	{Group: 0x3006, Element: 0x0044},
+	{Group: 0x3006, Element: 0x0045},
+	{Group: 0x3006, Element: 0x0046},
+	{Group: 0x3006, Element: 0x0048},
+	{Group: 0x3006, Element: 0x0049},
+	{Group: 0x3006, Element: 0x0050},
+	{Group: 0x3006, Element: 0x0080},
+	{Group: 0x3006, Element: 0x0082},
+	{Group: 0x3006, Element: 0x0084},
+	{Group: 0x3006, Element: 0x0085},
+	{Group: 0x3006, Element: 0x0086},
+	{Group: 0x3006, Element: 0x0088},
+	{Group: 0x3006, Element: 0x00A0},
+	{Group: 0x3006, Element: 0x00A4},
+	{Group: 0x3006, Element: 0x00A6},
+	{Group: 0x3006, Element: 0x00B0},
+	{Group: 0x3006, Element: 0x00B2},
+	{Group: 0x3006, Element: 0x00B4},
+	{Group: 0x3006, Element: 0x00B6},
+	{Group: 0x3006, Element: 0x00B7},
+	{Group: 0x3006, Element: 0x00B8},
+	{Group: 0x3006, Element: 0x00C0},
+	{Group: 0x3006, Element: 0x00C2},
+	{Group: 0x3006, Element: 0x00C4},
+	{Group: 0x3006, Element: 0x00C6},
+	{Group: 0x3006, Element: 0x00C8},
+	{Group: 0x3008, Element: 0x0010},
+	{Group: 0x3008, Element: 0x0012},
+	{Group: 0x3008, Element: 0x0014},
+	{Group: 0x3008, Element: 0x0016},
+	{Group: 0x3008, Element: 0x0020},
+	{Group: 0x3008, Element: 0x0021},
+	{Group: 0x3008, Element: 0x0022},
+	{Group: 0x3008, Element: 0x0024},
+	{Group: 0x3008, Element: 0x0025},
+	{Group: 0x3008, Element: 0x002A},
+	{Group: 0x3008, Element: 0x002B},
+	{Group: 0x3008, Element: 0x002C},
+	{Group: 0x3008, Element: 0x0030},
+	{Group: 0x3008, Element: 0x0032},
+	{Group: 0x3008, Element: 0x0033},
+	{Group: 0x3008, Element: 0x0036},
+	{Group: 0x3008, Element: 0x0037},
+	{Group: 0x3008, Element: 0x003A},
+	{Group: 0x3008, Element: 0x003B},
+	{Group: 0x3008, Element: 0x0040},
+	{Group: 0x3008, Element: 0x0041},
+	{Group: 0x3008, Element: 0x0042},
+	{Group: 0x3008, Element: 0x0044},
+	{Group: 0x3008, Element: 0x0045},
+	{Group: 0x3008, Element: 0x0046},
+	{Group: 0x3008, Element: 0x0047},
+	{Group: 0x3008, Element: 0x0048},
+	{Group: 0x3008, Element: 0x0050},
+	{Group: 0x3008, Element: 0x0052},
+	{Group: 0x3008, Element: 0x0054},
+	{Group: 0x3008, Element: 0x0056},
+	{Group: 0x3008, Element: 0x005A},
+	{Group: 0x3008, Element: 0x0060},
+	{Group: 0x3008, Element: 0x0061},
+	{Group: 0x3008, Element: 0x0062},
+	{Group: 0x3008, Element: 0x0063},
+	{Group: 0x3008, Element: 0x0064},
+	{Group: 0x3008, Element: 0x0065},
+	{Group: 0x3008, Element: 0x0066},
+	{Group: 0x3008, Element: 0x0068},
+	{Group: 0x3008, Element: 0x006A},
+	{Group: 0x3008, Element: 0x0070},
+	{Group: 0x3008, Element: 0x0072},
+	{Group: 0x3008, Element: 0x0074},
+	{Group: 0x3008, Element: 0x0076},
+	{Group: 0x3008, Element: 0x0078},
+	{Group: 0x3008, Element: 0x007A},
+	{Group: 0x3008, Element: 0x0080},
+	{Group: 0x3008, Element: 0x0082},
+	{Group: 0x3008, Element: 0x0090},
+	{Group: 0x3008, Element: 0x0092},
+	{Group: 0x3008, Element: 0x00A0},
+	{Group: 0x3008, Element: 0x00B0},
+	{Group: 0x3008, Element: 0x00C0},
+	{Group: 0x3008, Element: 0x00D0},
+	{Group: 0x3008, Element: 0x00E0},
+	{Group: 0x3008, Element: 0x00F0},
+	{Group: 0x3008, Element: 0x00F2},
+	{Group: 0x3008, Element: 0x00F4},
+	{Group: 0x3008, Element: 0x00F6},
+	{Group: 0x3008, Element: 0x0100},
+	{Group: 0x3008, Element: 0x0105},
+	{Group: 0x3008, Element: 0x0110},
+	{Group: 0x3008, Element: 0x0116},
+	{Group: 0x3008, Element: 0x0120},
+	{Group: 0x3008, Element: 0x0122},
+	{Group: 0x3008, Element: 0x0130},
+	{Group: 0x3008, Element: 0x0132},
+	{Group: 0x3008, Element: 0x0134},
+	{Group: 0x3008, Element: 0x0136},
+	{Group: 0x3008, Element: 0x0138},
+	{Group: 0x3008, Element: 0x013A},
+	{Group: 0x3008, Element: 0x013C},
+	{Group: 0x3008, Element: 0x0140},
+	{Group: 0x3008, Element: 0x0142},
+	{Group: 0x3008, Element: 0x0150},
+	{Group: 0x3008, Element: 0x0152},
+	{Group: 0x3008, Element: 0x0160},
+	{Group: 0x3008, Element: 0x0162},
+	{Group: 0x3008, Element: 0x0164},
+	{Group: 0x3008, Element: 0x0166},
+	{Group: 0x3008, Element: 0x0168},
+	{Group: 0x3008, Element: 0x0200},
+	{Group: 0x3008, Element: 0x0202},
+	{Group: 0x3008, Element: 0x0220},
+	{Group: 0x3008, Element: 0x0223},
+	{Group: 0x3008, Element: 0x0224},
+	{Group: 0x3008, Element: 0x0230},
+	{Group: 0x3008, Element: 0x0240},
+	{Group: 0x3008, Element: 0x0250},
+	{Group: 0x3008, Element: 0x0251},
+	{Group: 0x300A, Element: 0x0002},
+	{Group: 0x300A, Element: 0x0003},
+	{Group: 0x300A, Element: 0x0004},
+	{Group: 0x300A, Element: 0x0006},
+	{Group: 0x300A, Element: 0x0007},
+	{Group: 0x300A, Element: 0x0009},
+	{Group: 0x300A, Element: 0x000A},
+	{Group: 0x300A, Element: 0x000B},
+	{Group: 0x300A, Element: 0x000C},
+	{Group: 0x300A, Element: 0x000E},
+	{Group: 0x300A, Element: 0x0010},
+	{Group: 0x300A, Element: 0x0012},
+	{Group: 0x300A, Element: 0x0013},
+	{Group: 0x300A, Element: 0x0014},
+	{Group: 0x300A, Element: 0x0015},
+	{Group: 0x300A, Element: 0x0016},
+	{Group: 0x300A, Element: 0x0018},
+	{Group: 0x300A, Element: 0x001A},
+	{Group: 0x300A, Element: 0x0020},
+	{Group: 0x300A, Element: 0x0021},
+	{Group: 0x300A, Element: 0x0022},
+	{Group: 0x300A, Element: 0x0023},
+	{Group: 0x300A, Element: 0x0025},
+	{Group: 0x300A, Element: 0x0026},
+	{Group: 0x300A, Element: 0x0027},
+	{Group: 0x300A, Element: 0x0028},
+	{Group: 0x300A, Element: 0x002A},
+	{Group: 0x300A, Element: 0x002B},
+	{Group: 0x300A, Element: 0x002C},
+	{Group: 0x300A, Element: 0x002D},
+	{Group: 0x300A, Element: 0x0040},
+	{Group: 0x300A, Element: 0x0042},
+	{Group: 0x300A, Element: 0x0043},
+	{Group: 0x300A, Element: 0x0044},
+	{Group: 0x300A, Element: 0x0046},
+	{Group: 0x300A, Element: 0x0048},
+	{Group: 0x300A, Element: 0x004A},
+	{Group: 0x300A, Element: 0x004B},
+	{Group: 0x300A, Element: 0x004C},
+	{Group: 0x300A, Element: 0x004E},
+	{Group: 0x300A, Element: 0x004F},
+	{Group: 0x300A, Element: 0x0050},
+	{Group: 0x300A, Element: 0x0051},
+	{Group: 0x300A, Element: 0x0052},
+	{Group: 0x300A, Element: 0x0053},
+	{Group: 0x300A, Element: 0x0055},
+	{Group: 0x300A, Element: 0x0070},
+	{Group: 0x300A, Element: 0x0071},
+	{Group: 0x300A, Element: 0x0072},
+	{Group: 0x300A, Element: 0x0078},
+	{Group: 0x300A, Element: 0x0079},
+	{Group: 0x300A, Element: 0x007A},
+	{Group: 0x300A, Element: 0x007B},
+	{Group: 0x300A, Element: 0x0080},
+	{Group: 0x300A, Element: 0x0082},
+	{Group: 0x300A, Element: 0x0084},
+	{Group: 0x300A, Element: 0x0086},
+	{Group: 0x300A, Element: 0x0088},
+	{Group: 0x300A, Element: 0x0089},
+	{Group: 0x300A, Element: 0x008A},
+	{Group: 0x300A, Element: 0x00A0},
+	{Group: 0x300A, Element: 0x00A2},
+	{Group: 0x300A, Element: 0x00A4},
+	{Group: 0x300A, Element: 0x00B0},
+	{Group: 0x300A, Element: 0x00B2},
+	{Group: 0x300A, Element: 0x00B3},
+	{Group: 0x300A, Element: 0x00B4},
+	{Group: 0x300A, Element: 0x00B6},
+	{Group: 0x300A, Element: 0x00B8},
+	{Group: 0x300A, Element: 0x00BA},
+	{Group: 0x300A, Element: 0x00BB},
+	{Group: 0x300A, Element: 0x00BC},
+	{Group: 0x300A, Element: 0x00BE},
+	{Group: 0x300A, Element: 0x00C0},
+	{Group: 0x300A, Element: 0x00C2},
+	{Group: 0x300A, Element: 0x00C3},
+	{Group: 0x300A, Element: 0x00C4},
+	{Group: 0x300A, Element: 0x00C6},
+	{Group: 0x300A, Element: 0x00C7},
+	{Group: 0x300A, Element: 0x00C8},
+	{Group: 0x300A, Element: 0x00CA},
+	{Group: 0x300A, Element: 0x00CC},
+	{Group: 0x300A, Element: 0x00CE},
+	{Group: 0x300A, Element: 0x00D0},
+	{Group: 0x300A, Element: 0x00D1},
+	{Group: 0x300A, Element: 0x00D2},
+	{Group: 0x300A, Element: 0x00D3},
+	{Group: 0x300A, Element: 0x00D4},
+	{Group: 0x300A, Element: 0x00D5},
+	{Group: 0x300A, Element: 0x00D6},
+	{Group: 0x300A, Element: 0x00D7},
+	{Group: 0x300A, Element: 0x00D8},
+	{Group: 0x300A, Element: 0x00D9},
+	{Group: 0x300A, Element: 0x00DA},
+	{Group: 0x300A, Element: 0x00DB},
+	{Group: 0x300A, Element: 0x00DC},
+	{Group: 0x300A, Element: 0x00DD},
+	{Group: 0x300A, Element: 0x00E0},
+	{Group: 0x300A, Element: 0x00E1},
+	{Group: 0x300A, Element: 0x00E2},
+	{Group: 0x300A, Element: 0x00E3},
+	{Group: 0x300A, Element: 0x00E4},
+	{Group: 0x300A, Element: 0x00E5},
+	{Group: 0x300A, Element: 0x00E6},
+	{Group: 0x300A, Element: 0x00E7},
+	{Group: 0x300A, Element: 0x00E8},
+	{Group: 0x300A, Element: 0x00E9},
+	{Group: 0x300A, Element: 0x00EA},
+	{Group: 0x300A, Element: 0x00EB},
+	{Group: 0x300A, Element: 0x00EC},
+	{Group: 0x300A, Element: 0x00ED},
+	{Group: 0x300A, Element: 0x00EE},
+	{Group: 0x300A, Element: 0x00F0},
+	{Group: 0x300A, Element: 0x00F2},
+	{Group: 0x300A, Element: 0x00F3},
+	{Group: 0x300A, Element: 0x00F4},
+	{Group: 0x300A, Element: 0x00F5},
+	{Group: 0x300A, Element: 0x00F6},
+	{Group: 0x300A, Element: 0x00F7},
+	{Group: 0x300A, Element: 0x00F8},
+	{Group: 0x300A, Element: 0x00F9},
+	{Group: 0x300A, Element: 0x00FA},
+	{Group: 0x300A, Element: 0x00FB},
+	{Group: 0x300A, Element: 0x00FC},
+	{Group: 0x300A, Element: 0x00FE},
+	{Group: 0x300A, Element: 0x0100},
+	{Group: 0x300A, Element: 0x0102},
+	{Group: 0x300A, Element: 0x0104},
+	{Group: 0x300A, Element: 0x0106},
+	{Group: 0x300A, Element: 0x0107},
+	{Group: 0x300A, Element: 0x0108},
+	{Group: 0x300A, Element: 0x0109},
+	{Group: 0x300A, Element: 0x010A},
+	{Group: 0x300A, Element: 0x010C},
+	{Group: 0x300A, Element: 0x010E},
+	{Group: 0x300A, Element: 0x0110},
+	{Group: 0x300A, Element: 0x0111},
+	{Group: 0x300A, Element: 0x0112},
+	{Group: 0x300A, Element: 0x0114},
+	{Group: 0x300A, Element: 0x0115},
+	{Group: 0x300A, Element: 0x0116},
+	{Group: 0x300A, Element: 0x0118},
+	{Group: 0x300A, Element: 0x011A},
+	{Group: 0x300A, Element: 0x011C},
+	{Group: 0x300A, Element: 0x011E},
+	{Group: 0x300A, Element: 0x011F},
+	{Group: 0x300A, Element: 0x0120},
+	{Group: 0x300A, Element: 0x0121},
+	{Group: 0x300A, Element: 0x0122},
+	{Group: 0x300A, Element: 0x0123},
+	{Group: 0x300A, Element: 0x0124},
+	{Group: 0x300A, Element: 0x0125},
+	{Group: 0x300A, Element: 0x0126},
+	{Group: 0x300A, Element: 0x0128},
+	{Group: 0x300A, Element: 0x0129},
+	{Group: 0x300A, Element: 0x012A},
+	{Group: 0x300A, Element: 0x012C},
+	{Group: 0x300A, Element: 0x012E},
+	{Group: 0x300A, Element: 0x0130},
+	{Group: 0x300A, Element: 0x0134},
+	{Group: 0x300A, Element: 0x0140},
+	{Group: 0x300A, Element: 0x0142},
+	{Group: 0x300A, Element: 0x0144},
+	{Group: 0x300A, Element: 0x0146},
+	{Group: 0x300A, Element: 0x0148},
+	{Group: 0x300A, Element: 0x014A},
+	{Group: 0x300A, Element: 0x014C},
+	{Group: 0x300A, Element: 0x014E},
+	{Group: 0x300A, Element: 0x0180},
+	{Group: 0x300A, Element: 0x0182},
+	{Group: 0x300A, Element: 0x0183},
+	{Group: 0x300A, Element: 0x0184},
+	{Group: 0x300A, Element: 0x0190},
+	{Group: 0x300A, Element: 0x0192},
+	{Group: 0x300A, Element: 0x0194},
+	{Group: 0x300A, Element: 0x0196},
+	{Group: 0x300A, Element: 0x0198},
+	{Group: 0x300A, Element: 0x0199},
+	{Group: 0x300A, Element: 0x019A},
+	{Group: 0x300A, Element: 0x01A0},
+	{Group: 0x300A, Element: 0x01A2},
+	{Group: 0x300A, Element: 0x01A4},
+	{Group: 0x300A, Element: 0x01A6},
+	{Group: 0x300A, Element: 0x01A8},
+	{Group: 0x300A, Element: 0x01B0},
+	{Group: 0x300A, Element: 0x01B2},
+	{Group: 0x300A, Element: 0x01B4},
+	{Group: 0x300A, Element: 0x01B6},
+	{Group: 0x300A, Element: 0x01B8},
+	{Group: 0x300A, Element: 0x01BA},
+	{Group: 0x300A, Element: 0x01BC},
+	{Group: 0x300A, Element: 0x01D0},
+	{Group: 0x300A, Element: 0x01D2},
+	{Group: 0x300A, Element: 0x01D4},
+	{Group: 0x300A, Element: 0x01D6},
+	{Group: 0x300A, Element: 0x0200},
+	{Group: 0x300A, Element: 0x0202},
+	{Group: 0x300A, Element: 0x0206},
+	{Group: 0x300A, Element: 0x0210},
+	{Group: 0x300A, Element: 0x0212},
+	{Group: 0x300A, Element: 0x0214},
+	{Group: 0x300A, Element: 0x0216},
+	{Group: 0x300A, Element: 0x0218},
+	{Group: 0x300A, Element: 0x021A},
+	{Group: 0x300A, Element: 0x0222},
+	{Group: 0x300A, Element: 0x0224},
+	{Group: 0x300A, Element: 0x0226},
+	{Group: 0x300A, Element: 0x0228},
+	{Group: 0x300A, Element: 0x0229},
+	{Group: 0x300A, Element: 0x022A},
+	{Group: 0x300A, Element: 0x022B},
+	{Group: 0x300A, Element: 0x022C},
+	{Group: 0x300A, Element: 0x022E},
+	{Group: 0x300A, Element: 0x0230},
+	{Group: 0x300A, Element: 0x0232},
+	{Group: 0x300A, Element: 0x0234},
+	{Group: 0x300A, Element: 0x0236},
+	{Group: 0x300A, Element: 0x0238},
+	{Group: 0x300A, Element: 0x0240},
+	{Group: 0x300A, Element: 0x0242},
+	{Group: 0x300A, Element: 0x0244},
+	{Group: 0x300A, Element: 0x0250},
+	{Group: 0x300A, Element: 0x0260},
+	{Group: 0x300A, Element: 0x0262},
+	{Group: 0x300A, Element: 0x0263},
+	{Group: 0x300A, Element: 0x0264},
+	{Group: 0x300A, Element: 0x0266},
+	{Group: 0x300A, Element: 0x026A},
+	{Group: 0x300A, Element: 0x026C},
+	{Group: 0x300A, Element: 0x0280},
+	{Group: 0x300A, Element: 0x0282},
+	{Group: 0x300A, Element: 0x0284},
+	{Group: 0x300A, Element: 0x0286},
+	{Group: 0x300A, Element: 0x0288},
+	{Group: 0x300A, Element: 0x028A},
+	{Group: 0x300A, Element: 0x028C},
+	{Group: 0x300A, Element: 0x0290},
+	{Group: 0x300A, Element: 0x0291},
+	{Group: 0x300A, Element: 0x0292},
+	{Group: 0x300A, Element: 0x0294},
+	{Group: 0x300A, Element: 0x0296},
+	{Group: 0x300A, Element: 0x0298},
+	{Group: 0x300A, Element: 0x029C},
+	{Group: 0x300A, Element: 0x029E},
+	{Group: 0x300A, Element: 0x02A0},
+	{Group: 0x300A, Element: 0x02A2},
+	{Group: 0x300A, Element: 0x02A4},
+	{Group: 0x300A, Element: 0x02B0},
+	{Group: 0x300A, Element: 0x02B2},
+	{Group: 0x300A, Element: 0x02B3},
+	{Group: 0x300A, Element: 0x02B4},
+	{Group: 0x300A, Element: 0x02B8},
+	{Group: 0x300A, Element: 0x02BA},
+	{Group: 0x300A, Element: 0x02C8},
+	{Group: 0x300A, Element: 0x02D0},
+	{Group: 0x300A, Element: 0x02D2},
+	{Group: 0x300A, Element: 0x02D4},
+	{Group: 0x300A, Element: 0x02D6},
+	{Group: 0x300A, Element: 0x02E0},
+	{Group: 0x300A, Element: 0x02E1},
+	{Group: 0x300A, Element: 0x02E2},
+	{Group: 0x300A, Element: 0x02E3},
+	{Group: 0x300A, Element: 0x02E4},
+	{Group: 0x300A, Element: 0x02E5},
+	{Group: 0x300A, Element: 0x02E6},
+	{Group: 0x300A, Element: 0x02E7},
+	{Group: 0x300A, Element: 0x02E8},
+	{Group: 0x300A, Element: 0x02EA},
+	{Group: 0x300A, Element: 0x02EB},
+	{Group: 0x300A, Element: 0x0302},
+	{Group: 0x300A, Element: 0x0304},
+	{Group: 0x300A, Element: 0x0306},
+	{Group: 0x300A, Element: 0x0308},
+	{Group: 0x300A, Element: 0x030A},
+	{Group: 0x300A, Element: 0x030C},
+	{Group: 0x300A, Element: 0x030D},
+	{Group: 0x300A, Element: 0x030F},
+	{Group: 0x300A, Element: 0x0312},
+	{Group: 0x300A, Element: 0x0314},
+	{Group: 0x300A, Element: 0x0316},
+	{Group: 0x300A, Element: 0x0318},
+	{Group: 0x300A, Element: 0x0320},
+	{Group: 0x300A, Element: 0x0322},
+	{Group: 0x300A, Element: 0x0330},
+	{Group: 0x300A, Element: 0x0332},
+	{Group: 0x300A, Element: 0x0334},
+	{Group: 0x300A, Element: 0x0336},
+	{Group: 0x300A, Element: 0x0338},
+	{Group: 0x300A, Element: 0x033A},
+	{Group: 0x300A, Element: 0x033C},
+	{Group: 0x300A, Element: 0x0340},
+	{Group: 0x300A, Element: 0x0342},
+	{Group: 0x300A, Element: 0x0344},
+	{Group: 0x300A, Element: 0x0346},
+	{Group: 0x300A, Element: 0x0348},
+	{Group: 0x300A, Element: 0x034A},
+	{Group: 0x300A, Element: 0x034C},
+	{Group: 0x300A, Element: 0x0350},
+	{Group: 0x300A, Element: 0x0352},
+	{Group: 0x300A, Element: 0x0354},
+	{Group: 0x300A, Element: 0x0356},
+	{Group: 0x300A, Element: 0x0358},
+	{Group: 0x300A, Element: 0x035A},
+	{Group: 0x300A, Element: 0x0360},
+	{Group: 0x300A, Element: 0x0362},
+	{Group: 0x300A, Element: 0x0364},
+	{Group: 0x300A, Element: 0x0366},
+	{Group: 0x300A, Element: 0x0370},
+	{Group: 0x300A, Element: 0x0372},
+	{Group: 0x300A, Element: 0x0374},
+	{Group: 0x300A, Element: 0x0380},
+	{Group: 0x300A, Element: 0x0382},
+	{Group: 0x300A, Element: 0x0384},
+	{Group: 0x300A, Element: 0x0386},
+	{Group: 0x300A, Element: 0x0388},
+	{Group: 0x300A, Element: 0x038A},
+	{Group: 0x300A, Element: 0x0390},
+	{Group: 0x300A, Element: 0x0392},
+	{Group: 0x300A, Element: 0x0394},
+	{Group: 0x300A, Element: 0x0396},
+	{Group: 0x300A, Element: 0x0398},
+	{Group: 0x300A, Element: 0x039A},
+	{Group: 0x300A, Element: 0x03A0},
+	{Group: 0x300A, Element: 0x03A2},
+	{Group: 0x300A, Element: 0x03A4},
+	{Group: 0x300A, Element: 0x03A6},
+	{Group: 0x300A, Element: 0x03A8},
+	{Group: 0x300A, Element: 0x03AA},
+	{Group: 0x300A, Element: 0x03AC},
+	{Group: 0x300A, Element: 0x0401},
+	{Group: 0x300A, Element: 0x0402},
+	{Group: 0x300A, Element: 0x0410},
+	{Group: 0x300A, Element: 0x0412},
+	{Group: 0x300A, Element: 0x0420},
+	{Group: 0x300A, Element: 0x0421},
+	{Group: 0x300A, Element: 0x0422},
+	{Group: 0x300A, Element: 0x0423},
+	{Group: 0x300A, Element: 0x0424},
+	{Group: 0x300A, Element: 0x0431},
+	{Group: 0x300A, Element: 0x0432},
+	{Group: 0x300A, Element: 0x0433},
+	{Group: 0x300A, Element: 0x0434},
+	{Group: 0x300A, Element: 0x0435},
+	{Group: 0x300A, Element: 0x0436},
+	{Group: 0x300C, Element: 0x0002},
+	{Group: 0x300C, Element: 0x0004},
+	{Group: 0x300C, Element: 0x0006},
+	{Group: 0x300C, Element: 0x0007},
+	{Group: 0x300C, Element: 0x0008},
+	{Group: 0x300C, Element: 0x0009},
+	{Group: 0x300C, Element: 0x000A},
+	{Group: 0x300C, Element: 0x000C},
+	{Group: 0x300C, Element: 0x000E},
+	{Group: 0x300C, Element: 0x0020},
+	{Group: 0x300C, Element: 0x0022},
+	{Group: 0x300C, Element: 0x0040},
+	{Group: 0x300C, Element: 0x0042},
+	{Group: 0x300C, Element: 0x0050},
+	{Group: 0x300C, Element: 0x0051},
+	{Group: 0x300C, Element: 0x0055},
+	{Group: 0x300C, Element: 0x0060},
+	{Group: 0x300C, Element: 0x006A},
+	{Group: 0x300C, Element: 0x0080},
+	{Group: 0x300C, Element: 0x00A0},
+	{Group: 0x300C, Element: 0x00B0},
+	{Group: 0x300C, Element: 0x00C0},
+	{Group: 0x300C, Element: 0x00D0},
+	{Group: 0x300C, Element: 0x00E0},
+	{Group: 0x300C, Element: 0x00F0},
+	{Group: 0x300C, Element: 0x00F2},
+	{Group: 0x300C, Element: 0x00F4},
+	{Group: 0x300C, Element: 0x00F6},
+	{Group: 0x300C, Element: 0x0100},
+	{Group: 0x300C, Element: 0x0102},
+	{Group: 0x300C, Element: 0x0104},
+	{Group: 0x300E, Element: 0x0002},
+	{Group: 0x300E, Element: 0x0004},
+	{Group: 0x300E, Element: 0x0005},
+	{Group: 0x300E, Element: 0x0008},
+	{Group: 0x4010, Element: 0x0001},
+	{Group: 0x4010, Element: 0x0002},
+	{Group: 0x4010, Element: 0x0004},
+	{Group: 0x4010, Element: 0x1001},
+	{Group: 0x4010, Element: 0x1004},
+	{Group: 0x4010, Element: 0x1005},
+	{Group: 0x4010, Element: 0x1006},
+	{Group: 0x4010, Element: 0x1007},
+	{Group: 0x4010, Element: 0x1008},
+	{Group: 0x4010, Element: 0x1009},
+	{Group: 0x4010, Element: 0x100A},
+	{Group: 0x4010, Element: 0x1010},
+	{Group: 0x4010, Element: 0x1011},
+	{Group: 0x4010, Element: 0x1012},
+	{Group: 0x4010, Element: 0x1013},
+	{Group: 0x4010, Element: 0x1014},
+	{Group: 0x4010, Element: 0x1015},
+	{Group: 0x4010, Element: 0x1016},
+	{Group: 0x4010, Element: 0x1017},
+	{Group: 0x4010, Element: 0x1018},
+	{Group: 0x4010, Element: 0x1019},
+	{Group: 0x4010, Element: 0x101A},
+	{Group: 0x4010, Element: 0x101B},
+	{Group: 0x4010, Element: 0x101C},
+	{Group: 0x4010, Element: 0x101D},
+	{Group: 0x4010, Element: 0x101E},
+	{Group: 0x4010, Element: 0x101F},
+	{Group: 0x4010, Element: 0x1020},
+	{Group: 0x4010, Element: 0x1021},
+	{Group: 0x4010, Element: 0x1023},
+	{Group: 0x4010, Element: 0x1024},
+	{Group: 0x4010, Element: 0x1025},
+	{Group: 0x4010, Element: 0x1026},
+	{Group: 0x4010, Element: 0x1027},
+	{Group: 0x4010, Element: 0x1028},
+	{Group: 0x4010, Element: 0x1029},
+	{Group: 0x4010, Element: 0x102A},
+	{Group: 0x4010, Element: 0x102B},
+	{Group: 0x4010, Element: 0x1031},
+	{Group: 0x4010, Element: 0x1033},
+	{Group: 0x4010, Element: 0x1034},
+	{Group: 0x4010, Element: 0x1037},
+	{Group: 0x4010, Element: 0x1038},
+	{Group: 0x4010, Element: 0x1039},
+	{Group: 0x4010, Element: 0x103A},
+	{Group: 0x4010, Element: 0x1041},
+	{Group: 0x4010, Element: 0x1042},
+	{Group: 0x4010, Element: 0x1043},
+	{Group: 0x4010, Element: 0x1044},
+	{Group: 0x4010, Element: 0x1045},
+	{Group: 0x4010, Element: 0x1046},
+	{Group: 0x4010, Element: 0x1047},
+	{Group: 0x4010, Element: 0x1048},
+	{Group: 0x4010, Element: 0x1051},
+	{Group: 0x4010, Element: 0x1052},
+	{Group: 0x4010, Element: 0x1053},
+	{Group: 0x4010, Element: 0x1054},
+	{Group: 0x4010, Element: 0x1055},
+	{Group: 0x4010, Element: 0x1056},
+	{Group: 0x4010, Element: 0x1058},
+	{Group: 0x4010, Element: 0x1059},
+	{Group: 0x4010, Element: 0x1060},
+	{Group: 0x4010, Element: 0x1061},
+	{Group: 0x4010, Element: 0x1062},
+	{Group: 0x4010, Element: 0x1064},
+	{Group: 0x4010, Element: 0x1067},
+	{Group: 0x4010, Element: 0x1068},
+	{Group: 0x4010, Element: 0x1069},
+	{Group: 0x4010, Element: 0x106C},
+	{Group: 0x4FFE, Element: 0x0001},
+	{Group: 0x5200, Element: 0x9229},
+	{Group: 0x5200, Element: 0x9230},
+	{Group: 0x5400, Element: 0x0100},
+	{Group: 0x5400, Element: 0x0110},
+	{Group: 0x5400, Element: 0x0112},
+	{Group: 0x5400, Element: 0x1004},
+	{Group: 0x5400, Element: 0x1006},
+	{Group: 0x5400, Element: 0x100A},
+	{Group: 0x5400, Element: 0x1010},
+	{Group: 0x5600, Element: 0x0010},
+	{Group: 0x5600, Element: 0x0020},
+	{Group: 0x7FE0, Element: 0x0010},
+	{Group: 0xFFFA, Element: 0xFFFA},
+	{Group: 0xFFFC, Element: 0xFFFC},
+	{Group: 0xFFFE, Element: 0xE000},
+	{Group: 0xFFFE, Element: 0xE00D},
+	{Group: 0xFFFE, Element: 0xE0DD},
+	{Group: 0x0022, Element: 0x1415},
+	{Group: 0x0022, Element: 0x1420},
+	{Group: 0x0022, Element: 0x1423},
+	{Group: 0x0022, Element: 0x1436},
+	{Group: 0x0022, Element: 0x1443},
+	{Group: 0x0022, Element: 0x1445},
+	{Group: 0x0022, Element: 0x1450},
+	{Group: 0x0022, Element: 0x1452},
+	{Group: 0x0022, Element: 0x1454},
+	{Group: 0x0022, Element: 0x1458},
+	{Group: 0x0022, Element: 0x1460},
+	{Group: 0x0022, Element: 0x1463},
+	{Group: 0x0022, Element: 0x1465},
+	{Group: 0x0022, Element: 0x1466},
+	{Group: 0x0022, Element: 0x1467},
+	{Group: 0x0022, Element: 0x1468},
+	{Group: 0x0022, Element: 0x1470},
+	{Group: 0x0022, Element: 0x1472},
+	{Group: 0x0040, Element: 0x4001},
+	{Group: 0x0040, Element: 0x4002},
+	{Group: 0x0040, Element: 0x4003},
+	{Group: 0x0040, Element: 0x4004},
+	{Group: 0x0040, Element: 0x4006},
+	{Group: 0x0040, Element: 0x4015},
+	{Group: 0x0040, Element: 0x4016},
+	{Group: 0x0040, Element: 0x4022},
+	{Group: 0x0040, Element: 0x4023},
+	{Group: 0x0040, Element: 0x4031},
+	{Group: 0x0040, Element: 0x4032},
+	{Group: 0x0040, Element: 0xA161},
+	{Group: 0x0040, Element: 0xA162},
+	{Group: 0x0040, Element: 0xA163},
+	{Group: 0x0010, Element: 0x0200},
+	{Group: 0x3006, Element: 0x0018},
+	{Group: 0x300A, Element: 0x0088},
+	{Group: 0x300A, Element: 0x0089},
+	{Group: 0x300A, Element: 0x008A},
+	{Group: 0x300A, Element: 0x008C},
+	{Group: 0x300A, Element: 0x008D},
+	{Group: 0x300A, Element: 0x008E},
+	{Group: 0x300A, Element: 0x008F},
+	{Group: 0x0040, Element: 0xA171},
+	{Group: 0x300A, Element: 0x00EF},
+	{Group: 0x0066, Element: 0x0037},
+	{Group: 0x0066, Element: 0x0038},
+	{Group: 0x300A, Element: 0x021B},
+	{Group: 0x300A, Element: 0x021C},
+	{Group: 0x0008, Element: 0x0015},
+	{Group: 0x0000, Element: 0x0001},
+	{Group: 0x0000, Element: 0x0010},
+	{Group: 0x0000, Element: 0x0200},
+	{Group: 0x0000, Element: 0x0300},
+	{Group: 0x0000, Element: 0x0400},
+	{Group: 0x0000, Element: 0x0850},
+	{Group: 0x0000, Element: 0x0860},
+	{Group: 0x0000, Element: 0x4000},
+	{Group: 0x0000, Element: 0x4010},
+	{Group: 0x0000, Element: 0x5010},
+	{Group: 0x0000, Element: 0x5020},
+	{Group: 0x0000, Element: 0x5110},
+	{Group: 0x0000, Element: 0x5120},
+	{Group: 0x0000, Element: 0x5130},
+	{Group: 0x0000, Element: 0x5140},
+	{Group: 0x0000, Element: 0x5150},
+	{Group: 0x0000, Element: 0x5160},
+	{Group: 0x0000, Element: 0x5170},
+	{Group: 0x0000, Element: 0x5180},
+	{Group: 0x0000, Element: 0x5190},
+	{Group: 0x0000, Element: 0x51A0},
+	{Group: 0x0000, Element: 0x51B0},
+	{Group: 0x0008, Element: 0x0001},
+	{Group: 0x0008, Element: 0x0010},
+	{Group: 0x0008, Element: 0x0040},
+	{Group: 0x0008, Element: 0x0041},
+	{Group: 0x0008, Element: 0x1000},
+	{Group: 0x0008, Element: 0x4000},
+	{Group: 0x0010, Element: 0x1050},
+	{Group: 0x0018, Element: 0x1240},
+	{Group: 0x0018, Element: 0x4000},
+	{Group: 0x0018, Element: 0x5030},
+	{Group: 0x0018, Element: 0x5040},
+	{Group: 0x0020, Element: 0x0030},
+	{Group: 0x0020, Element: 0x0035},
+	{Group: 0x0020, Element: 0x0050},
+	{Group: 0x0020, Element: 0x0070},
+	{Group: 0x0020, Element: 0x0080},
+	{Group: 0x0020, Element: 0x1001},
+	{Group: 0x0020, Element: 0x1003},
+	{Group: 0x0020, Element: 0x1005},
+	{Group: 0x0020, Element: 0x1020},
+	{Group: 0x0020, Element: 0x3401},
+	{Group: 0x0020, Element: 0x3402},
+	{Group: 0x0020, Element: 0x3403},
+	{Group: 0x0020, Element: 0x3404},
+	{Group: 0x0020, Element: 0x3405},
+	{Group: 0x0020, Element: 0x3406},
+	{Group: 0x0020, Element: 0x5000},
+	{Group: 0x0020, Element: 0x5002},
+	{Group: 0x0028, Element: 0x0005},
+	{Group: 0x0028, Element: 0x0040},
+	{Group: 0x0028, Element: 0x0050},
+	{Group: 0x0028, Element: 0x0060},
+	{Group: 0x0028, Element: 0x0104},
+	{Group: 0x0028, Element: 0x0105},
+	{Group: 0x0028, Element: 0x0200},
+	{Group: 0x0028, Element: 0x1080},
+	{Group: 0x0028, Element: 0x1100},
+	{Group: 0x0028, Element: 0x1200},
+	{Group: 0x0028, Element: 0x4000},
+	{Group: 0x4000, Element: 0x0000},
+	{Group: 0x4000, Element: 0x0010},
+	{Group: 0x4000, Element: 0x4000},
+	{Group: 0x0028, Element: 0x005F},
+	{Group: 0x0028, Element: 0x0061},
+	{Group: 0x0028, Element: 0x0062},
+	{Group: 0x0028, Element: 0x0063},
+	{Group: 0x0028, Element: 0x0065},
+	{Group: 0x0028, Element: 0x0066},
+	{Group: 0x0028, Element: 0x0068},
+	{Group: 0x0028, Element: 0x0069},
+	{Group: 0x0028, Element: 0x0070},
+	{Group: 0x0028, Element: 0x0071},
+	{Group: 0x0028, Element: 0x0080},
+	{Group: 0x0028, Element: 0x0081},
+	{Group: 0x0028, Element: 0x0082},
+	{Group: 0x0028, Element: 0x0090},
+	{Group: 0x0028, Element: 0x0091},
+	{Group: 0x0028, Element: 0x0092},
+	{Group: 0x0028, Element: 0x0093},
+	{Group: 0x0028, Element: 0x0094},
+	{Group: 0x0028, Element: 0x0400},
+	{Group: 0x0028, Element: 0x0401},
+	{Group: 0x0028, Element: 0x0402},
+	{Group: 0x0028, Element: 0x0403},
+	{Group: 0x0028, Element: 0x0404},
+	{Group: 0x0028, Element: 0x0410},
+	{Group: 0x0028, Element: 0x0411},
+	{Group: 0x0028, Element: 0x0412},
+	{Group: 0x0028, Element: 0x0413},
+	{Group: 0x0028, Element: 0x0700},
+	{Group: 0x0028, Element: 0x0701},
+	{Group: 0x0028, Element: 0x0702},
+	{Group: 0x0028, Element: 0x0710},
+	{Group: 0x0028, Element: 0x0720},
+	{Group: 0x0028, Element: 0x0721},
+	{Group: 0x0028, Element: 0x0722},
+	{Group: 0x0028, Element: 0x0730},
+	{Group: 0x0028, Element: 0x0740},
+	{Group: 0x0028, Element: 0x0800},
+	{Group: 0x0028, Element: 0x0802},
+	{Group: 0x0028, Element: 0x0803},
+	{Group: 0x0028, Element: 0x0804},
+	{Group: 0x0028, Element: 0x0808},
+	{Group: 0x1000, Element: 0x0000},
+	{Group: 0x1000, Element: 0x0010},
+	{Group: 0x1000, Element: 0x0011},
+	{Group: 0x1000, Element: 0x0012},
+	{Group: 0x1000, Element: 0x0013},
+	{Group: 0x1000, Element: 0x0014},
+	{Group: 0x1000, Element: 0x0015},
+	{Group: 0x1010, Element: 0x0000},
+	{Group: 0x1010, Element: 0x0004},
+	{Group: 0x7FE0, Element: 0x0020},
+	{Group: 0x7FE0, Element: 0x0030},
+	{Group: 0x7FE0, Element: 0x0040},
+	{Group: 0x0000, Element: 0x0001},
+	{Group: 0x0000, Element: 0x0010},
+	{Group: 0x0000, Element: 0x0200},
+	{Group: 0x0000, Element: 0x0300},
+	{Group: 0x0000, Element: 0x0400},
+	{Group: 0x0000, Element: 0x0850},
+	{Group: 0x0000, Element: 0x0860},
+	{Group: 0x0000, Element: 0x4000},
+	{Group: 0x0000, Element: 0x4010},
+	{Group: 0x0000, Element: 0x5010},
+	{Group: 0x0000, Element: 0x5020},
+	{Group: 0x0000, Element: 0x5110},
+	{Group: 0x0000, Element: 0x5120},
+	{Group: 0x0000, Element: 0x5130},
+	{Group: 0x0000, Element: 0x5140},
+	{Group: 0x0000, Element: 0x5150},
+	{Group: 0x0000, Element: 0x5160},
+	{Group: 0x0000, Element: 0x5170},
+	{Group: 0x0000, Element: 0x5180},
+	{Group: 0x0000, Element: 0x5190},
+	{Group: 0x0000, Element: 0x51A0},
+	{Group: 0x0000, Element: 0x51B0},
+	{Group: 0x0004, Element: 0x1504},
+	{Group: 0x0004, Element: 0x1600},
+	{Group: 0x0008, Element: 0x0001},
+	{Group: 0x0008, Element: 0x0010},
+	{Group: 0x0008, Element: 0x0024},
+	{Group: 0x0008, Element: 0x0025},
+	{Group: 0x0008, Element: 0x0034},
+	{Group: 0x0008, Element: 0x0035},
+	{Group: 0x0008, Element: 0x0040},
+	{Group: 0x0008, Element: 0x0041},
+	{Group: 0x0008, Element: 0x0042},
+	{Group: 0x0008, Element: 0x1000},
+	{Group: 0x0008, Element: 0x1100},
+	{Group: 0x0008, Element: 0x1130},
+	{Group: 0x0008, Element: 0x1145},
+	{Group: 0x0008, Element: 0x2110},
+	{Group: 0x0008, Element: 0x2200},
+	{Group: 0x0008, Element: 0x2204},
+	{Group: 0x0008, Element: 0x2208},
+	{Group: 0x0008, Element: 0x2240},
+	{Group: 0x0008, Element: 0x2242},
+	{Group: 0x0008, Element: 0x2244},
+	{Group: 0x0008, Element: 0x2246},
+	{Group: 0x0008, Element: 0x2251},
+	{Group: 0x0008, Element: 0x2253},
+	{Group: 0x0008, Element: 0x2255},
+	{Group: 0x0008, Element: 0x2256},
+	{Group: 0x0008, Element: 0x2257},
+	{Group: 0x0008, Element: 0x2258},
+	{Group: 0x0008, Element: 0x2259},
+	{Group: 0x0008, Element: 0x225A},
+	{Group: 0x0008, Element: 0x225C},
+	{Group: 0x0008, Element: 0x4000},
+	{Group: 0x0010, Element: 0x1050},
+	{Group: 0x0018, Element: 0x0030},
+	{Group: 0x0018, Element: 0x0032},
+	{Group: 0x0018, Element: 0x0033},
+	{Group: 0x0018, Element: 0x0037},
+	{Group: 0x0018, Element: 0x0039},
+	{Group: 0x0018, Element: 0x1011},
+	{Group: 0x0018, Element: 0x1017},
+	{Group: 0x0018, Element: 0x101A},
+	{Group: 0x0018, Element: 0x101B},
+	{Group: 0x0018, Element: 0x1141},
+	{Group: 0x0018, Element: 0x1146},
+	{Group: 0x0018, Element: 0x1240},
+	{Group: 0x0018, Element: 0x4000},
+	{Group: 0x0018, Element: 0x5021},
+	{Group: 0x0018, Element: 0x5030},
+	{Group: 0x0018, Element: 0x5040},
+	{Group: 0x0018, Element: 0x5210},
+	{Group: 0x0018, Element: 0x5212},
+	{Group: 0x0018, Element: 0x6038},
+	{Group: 0x0018, Element: 0x603A},
+	{Group: 0x0018, Element: 0x603C},
+	{Group: 0x0018, Element: 0x603E},
+	{Group: 0x0018, Element: 0x6040},
+	{Group: 0x0018, Element: 0x6042},
+	{Group: 0x0018, Element: 0x9096},
+	{Group: 0x0018, Element: 0x9166},
+	{Group: 0x0018, Element: 0x9195},
+	{Group: 0x0018, Element: 0x9196},
+	{Group: 0x0020, Element: 0x0014},
+	{Group: 0x0020, Element: 0x0015},
+	{Group: 0x0020, Element: 0x0016},
+	{Group: 0x0020, Element: 0x0017},
+	{Group: 0x0020, Element: 0x0018},
+	{Group: 0x0020, Element: 0x0022},
+	{Group: 0x0020, Element: 0x0024},
+	{Group: 0x0020, Element: 0x0026},
+	{Group: 0x0020, Element: 0x0030},
+	{Group: 0x0020, Element: 0x0035},
+	{Group: 0x0020, Element: 0x0050},
+	{Group: 0x0020, Element: 0x0070},
+	{Group: 0x0020, Element: 0x0080},
+	{Group: 0x0020, Element: 0x00AA},
+	{Group: 0x0020, Element: 0x1000},
+	{Group: 0x0020, Element: 0x1001},
+	{Group: 0x0020, Element: 0x1003},
+	{Group: 0x0020, Element: 0x1004},
+	{Group: 0x0020, Element: 0x1005},
+	{Group: 0x0020, Element: 0x1020},
+	{Group: 0x0020, Element: 0x1070},
+	{Group: 0x0020, Element: 0x3401},
+	{Group: 0x0020, Element: 0x3402},
+	{Group: 0x0020, Element: 0x3403},
+	{Group: 0x0020, Element: 0x3404},
+	{Group: 0x0020, Element: 0x3405},
+	{Group: 0x0020, Element: 0x3406},
+	{Group: 0x0020, Element: 0x5000},
+	{Group: 0x0020, Element: 0x5002},
+	{Group: 0x0028, Element: 0x0005},
+	{Group: 0x0028, Element: 0x0012},
+	{Group: 0x0028, Element: 0x0040},
+	{Group: 0x0028, Element: 0x0050},
+	{Group: 0x0028, Element: 0x005F},
+	{Group: 0x0028, Element: 0x0060},
+	{Group: 0x0028, Element: 0x0061},
+	{Group: 0x0028, Element: 0x0062},
+	{Group: 0x0028, Element: 0x0063},
+	{Group: 0x0028, Element: 0x0065},
+	{Group: 0x0028, Element: 0x0066},
+	{Group: 0x0028, Element: 0x0068},
+	{Group: 0x0028, Element: 0x0069},
+	{Group: 0x0028, Element: 0x0070},
+	{Group: 0x0028, Element: 0x0071},
+	{Group: 0x0028, Element: 0x0080},
+	{Group: 0x0028, Element: 0x0081},
+	{Group: 0x0028, Element: 0x0082},
+	{Group: 0x0028, Element: 0x0090},
+	{Group: 0x0028, Element: 0x0091},
+	{Group: 0x0028, Element: 0x0092},
+	{Group: 0x0028, Element: 0x0093},
+	{Group: 0x0028, Element: 0x0094},
+	{Group: 0x0028, Element: 0x0104},
+	{Group: 0x0028, Element: 0x0105},
+	{Group: 0x0028, Element: 0x0110},
+	{Group: 0x0028, Element: 0x0111},
+	{Group: 0x0028, Element: 0x0200},
+	{Group: 0x0028, Element: 0x0400},
+	{Group: 0x0028, Element: 0x0401},
+	{Group: 0x0028, Element: 0x0402},
+	{Group: 0x0028, Element: 0x0403},
+	{Group: 0x0028, Element: 0x0404},
+	{Group: 0x0028, Element: 0x0700},
+	{Group: 0x0028, Element: 0x0701},
+	{Group: 0x0028, Element: 0x0702},
+	{Group: 0x0028, Element: 0x0710},
+	{Group: 0x0028, Element: 0x0720},
+	{Group: 0x0028, Element: 0x0721},
+	{Group: 0x0028, Element: 0x0722},
+	{Group: 0x0028, Element: 0x0730},
+	{Group: 0x0028, Element: 0x0740},
+	{Group: 0x0028, Element: 0x1080},
+	{Group: 0x0028, Element: 0x1100},
+	{Group: 0x0028, Element: 0x1111},
+	{Group: 0x0028, Element: 0x1112},
+	{Group: 0x0028, Element: 0x1113},
+	{Group: 0x0028, Element: 0x1200},
+	{Group: 0x0028, Element: 0x1211},
+	{Group: 0x0028, Element: 0x1212},
+	{Group: 0x0028, Element: 0x1213},
+	{Group: 0x0028, Element: 0x1214},
+	{Group: 0x0028, Element: 0x4000},
+	{Group: 0x0028, Element: 0x5000},
+	{Group: 0x0028, Element: 0x6030},
+	{Group: 0x0028, Element: 0x9099},
+	{Group: 0x0032, Element: 0x000A},
+	{Group: 0x0032, Element: 0x000C},
+	{Group: 0x0032, Element: 0x0012},
+	{Group: 0x0032, Element: 0x0032},
+	{Group: 0x0032, Element: 0x0033},
+	{Group: 0x0032, Element: 0x0034},
+	{Group: 0x0032, Element: 0x0035},
+	{Group: 0x0032, Element: 0x1000},
+	{Group: 0x0032, Element: 0x1001},
+	{Group: 0x0032, Element: 0x1010},
+	{Group: 0x0032, Element: 0x1011},
+	{Group: 0x0032, Element: 0x1020},
+	{Group: 0x0032, Element: 0x1021},
+	{Group: 0x0032, Element: 0x1030},
+	{Group: 0x0032, Element: 0x1040},
+	{Group: 0x0032, Element: 0x1041},
+	{Group: 0x0032, Element: 0x1050},
+	{Group: 0x0032, Element: 0x1051},
+	{Group: 0x0032, Element: 0x1055},
+	{Group: 0x0032, Element: 0x4000},
+	{Group: 0x0038, Element: 0x0011},
+	{Group: 0x0038, Element: 0x001A},
+	{Group: 0x0038, Element: 0x001B},
+	{Group: 0x0038, Element: 0x001C},
+	{Group: 0x0038, Element: 0x001D},
+	{Group: 0x0038, Element: 0x001E},
+	{Group: 0x0038, Element: 0x0030},
+	{Group: 0x0038, Element: 0x0032},
+	{Group: 0x0038, Element: 0x0040},
+	{Group: 0x0038, Element: 0x0044},
+	{Group: 0x0038, Element: 0x0061},
+	{Group: 0x0040, Element: 0x0307},
+	{Group: 0x0040, Element: 0x0330},
+	{Group: 0x0040, Element: 0x050A},
+	{Group: 0x0040, Element: 0x0550},
+	{Group: 0x0040, Element: 0x0552},
+	{Group: 0x0040, Element: 0x0553},
+	{Group: 0x0040, Element: 0x06FA},
+	{Group: 0x0040, Element: 0x08D8},
+	{Group: 0x0040, Element: 0x08DA},
+	{Group: 0x0040, Element: 0x09F8},
+	{Group: 0x0040, Element: 0x1006},
+	{Group: 0x0040, Element: 0x1007},
+	{Group: 0x0040, Element: 0x1060},
+	{Group: 0x0040, Element: 0x2001},
+	{Group: 0x0040, Element: 0x2006},
+	{Group: 0x0040, Element: 0x2007},
+	{Group: 0x0040, Element: 0xA007},
+	{Group: 0x0040, Element: 0xA020},
+	{Group: 0x0040, Element: 0xA021},
+	{Group: 0x0040, Element: 0xA022},
+	{Group: 0x0040, Element: 0xA023},
+	{Group: 0x0040, Element: 0xA024},
+	{Group: 0x0040, Element: 0xA026},
+	{Group: 0x0040, Element: 0xA028},
+	{Group: 0x0040, Element: 0xA047},
+	{Group: 0x0040, Element: 0xA057},
+	{Group: 0x0040, Element: 0xA060},
+	{Group: 0x0040, Element: 0xA066},
+	{Group: 0x0040, Element: 0xA067},
+	{Group: 0x0040, Element: 0xA068},
+	{Group: 0x0040, Element: 0xA070},
+	{Group: 0x0040, Element: 0xA074},
+	{Group: 0x0040, Element: 0xA076},
+	{Group: 0x0040, Element: 0xA085},
+	{Group: 0x0040, Element: 0xA089},
+	{Group: 0x0040, Element: 0xA090},
+	{Group: 0x0040, Element: 0xA110},
+	{Group: 0x0040, Element: 0xA112},
+	{Group: 0x0040, Element: 0xA125},
+	{Group: 0x0040, Element: 0xA167},
+	{Group: 0x0040, Element: 0xA16A},
+	{Group: 0x0040, Element: 0xA172},
+	{Group: 0x0040, Element: 0xA173},
+	{Group: 0x0040, Element: 0xA174},
+	{Group: 0x0040, Element: 0xA192},
+	{Group: 0x0040, Element: 0xA193},
+	{Group: 0x0040, Element: 0xA194},
+	{Group: 0x0040, Element: 0xA224},
+	{Group: 0x0040, Element: 0xA290},
+	{Group: 0x0040, Element: 0xA296},
+	{Group: 0x0040, Element: 0xA297},
+	{Group: 0x0040, Element: 0xA29A},
+	{Group: 0x0040, Element: 0xA307},
+	{Group: 0x0040, Element: 0xA313},
+	{Group: 0x0040, Element: 0xA33A},
+	{Group: 0x0040, Element: 0xA340},
+	{Group: 0x0040, Element: 0xA352},
+	{Group: 0x0040, Element: 0xA353},
+	{Group: 0x0040, Element: 0xA354},
+	{Group: 0x0040, Element: 0xA358},
+	{Group: 0x0040, Element: 0xA380},
+	{Group: 0x0040, Element: 0xA402},
+	{Group: 0x0040, Element: 0xA403},
+	{Group: 0x0040, Element: 0xA404},
+	{Group: 0x0040, Element: 0xA600},
+	{Group: 0x0040, Element: 0xA601},
+	{Group: 0x0040, Element: 0xA603},
+	{Group: 0x0040, Element: 0xA731},
+	{Group: 0x0040, Element: 0xA732},
+	{Group: 0x0040, Element: 0xA744},
+	{Group: 0x0040, Element: 0xA992},
+	{Group: 0x0040, Element: 0xDB06},
+	{Group: 0x0040, Element: 0xDB07},
+	{Group: 0x0040, Element: 0xDB0B},
+	{Group: 0x0040, Element: 0xDB0C},
+	{Group: 0x0040, Element: 0xDB0D},
+	{Group: 0x0054, Element: 0x1400},
+	{Group: 0x0054, Element: 0x1401},
+	{Group: 0x0070, Element: 0x0040},
+	{Group: 0x0070, Element: 0x0050},
+	{Group: 0x0070, Element: 0x0051},
+	{Group: 0x0070, Element: 0x0067},
+	{Group: 0x0074, Element: 0x1024},
+	{Group: 0x0074, Element: 0x1038},
+	{Group: 0x0074, Element: 0x103A},
+	{Group: 0x0074, Element: 0x1220},
+	{Group: 0x0074, Element: 0x1222},
+	{Group: 0x0088, Element: 0x0904},
+	{Group: 0x0088, Element: 0x0906},
+	{Group: 0x0088, Element: 0x0910},
+	{Group: 0x0088, Element: 0x0912},
+	{Group: 0x2000, Element: 0x0062},
+	{Group: 0x2000, Element: 0x0063},
+	{Group: 0x2000, Element: 0x0065},
+	{Group: 0x2000, Element: 0x0067},
+	{Group: 0x2000, Element: 0x0069},
+	{Group: 0x2000, Element: 0x006A},
+	{Group: 0x2000, Element: 0x0510},
+	{Group: 0x2020, Element: 0x0130},
+	{Group: 0x2020, Element: 0x0140},
+	{Group: 0x2040, Element: 0x0010},
+	{Group: 0x2040, Element: 0x0011},
+	{Group: 0x2040, Element: 0x0020},
+	{Group: 0x2040, Element: 0x0060},
+	{Group: 0x2040, Element: 0x0070},
+	{Group: 0x2040, Element: 0x0072},
+	{Group: 0x2040, Element: 0x0074},
+	{Group: 0x2040, Element: 0x0080},
+	{Group: 0x2040, Element: 0x0082},
+	{Group: 0x2040, Element: 0x0090},
+	{Group: 0x2040, Element: 0x0100},
+	{Group: 0x2040, Element: 0x0500},
+	{Group: 0x2100, Element: 0x0010},
+	{Group: 0x2100, Element: 0x0140},
+	{Group: 0x2100, Element: 0x0500},
+	{Group: 0x2110, Element: 0x0099},
+	{Group: 0x2120, Element: 0x0010},
+	{Group: 0x2120, Element: 0x0050},
+	{Group: 0x2120, Element: 0x0070},
+	{Group: 0x2130, Element: 0x0010},
+	{Group: 0x2130, Element: 0x0015},
+	{Group: 0x2130, Element: 0x0030},
+	{Group: 0x2130, Element: 0x0040},
+	{Group: 0x2130, Element: 0x0050},
+	{Group: 0x2130, Element: 0x0060},
+	{Group: 0x2130, Element: 0x0080},
+	{Group: 0x2130, Element: 0x00A0},
+	{Group: 0x2130, Element: 0x00C0},
+	{Group: 0x4000, Element: 0x0010},
+	{Group: 0x4000, Element: 0x4000},
+	{Group: 0x4008, Element: 0x0040},
+	{Group: 0x4008, Element: 0x0042},
+	{Group: 0x4008, Element: 0x0050},
+	{Group: 0x4008, Element: 0x00FF},
+	{Group: 0x4008, Element: 0x0100},
+	{Group: 0x4008, Element: 0x0101},
+	{Group: 0x4008, Element: 0x0102},
+	{Group: 0x4008, Element: 0x0103},
+	{Group: 0x4008, Element: 0x0108},
+	{Group: 0x4008, Element: 0x0109},
+	{Group: 0x4008, Element: 0x010A},
+	{Group: 0x4008, Element: 0x010B},
+	{Group: 0x4008, Element: 0x010C},
+	{Group: 0x4008, Element: 0x0111},
+	{Group: 0x4008, Element: 0x0112},
+	{Group: 0x4008, Element: 0x0113},
+	{Group: 0x4008, Element: 0x0114},
+	{Group: 0x4008, Element: 0x0115},
+	{Group: 0x4008, Element: 0x0117},
+	{Group: 0x4008, Element: 0x0118},
+	{Group: 0x4008, Element: 0x0119},
+	{Group: 0x4008, Element: 0x011A},
+	{Group: 0x4008, Element: 0x0200},
+	{Group: 0x4008, Element: 0x0202},
+	{Group: 0x4008, Element: 0x0210},
+	{Group: 0x4008, Element: 0x0212},
+	{Group: 0x4008, Element: 0x0300},
+	{Group: 0x4008, Element: 0x4000},
+	{Group: 0x7FE0, Element: 0x0020},
+	{Group: 0x7FE0, Element: 0x0030},
+	{Group: 0x7FE0, Element: 0x0040},
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// tagDiff is a tag that isn't the same in both files, the side a file
+// doesn't have it on is left out
+type tagDiff struct {
+	Tag     string `json:"tag"`
+	Keyword string `json:"keyword,omitempty"`
+	A       any    `json:"a,omitempty"`
+	B       any    `json:"b,omitempty"`
+}
+
+// datasetDiff lists the top level tags that differ between two
+// files, sequences are compared as a whole
+type datasetDiff struct {
+	OnlyA     []tagDiff `json:"onlyA"`
+	OnlyB     []tagDiff `json:"onlyB"`
+	Different []tagDiff `json:"different"`
+}
+
+// diffDatasets compares a and b tag by tag, only the tags in names
+// when it isn't empty, values are compared in the shape the tag
+// endpoints give them so padding doesn't count as a difference
+func diffDatasets(a, b dicom.Dataset, names []tag.Tag) (diff datasetDiff, err error) {
+	diff = datasetDiff{OnlyA: []tagDiff{}, OnlyB: []tagDiff{}, Different: []tagDiff{}}
+	values := func(ds dicom.Dataset) map[tag.Tag]any {
+		m := map[tag.Tag]any{}
+		for _, elem := range ds.Elements {
+			// the cache leaves pixel data out so it isn't compared
+			if elem.Tag == tag.PixelData {
+				continue
+			}
+			m[elem.Tag] = tagValue(elem)
+		}
+		return m
+	}
+	av, bv := values(a), values(b)
+
+	tags := names
+	if len(tags) == 0 {
+		for t := range av {
+			tags = append(tags, t)
+		}
+		for t := range bv {
+			if _, ok := av[t]; !ok {
+				tags = append(tags, t)
+			}
+		}
+	}
+	slices.SortFunc(tags, tag.Tag.Compare)
+	tags = slices.Compact(tags)
+
+	for _, t := range tags {
+		d := tagDiff{Tag: t.String()}
+		if info, err := tag.Find(t); err == nil {
+			d.Keyword = info.Name
+		}
+		va, inA := av[t]
+		vb, inB := bv[t]
+		switch {
+		case inA && !inB:
+			d.A = va
+			diff.OnlyA = append(diff.OnlyA, d)
+		case inB && !inA:
+			d.B = vb
+			diff.OnlyB = append(diff.OnlyB, d)
+		case inA && inB:
+			ja, err := json.Marshal(va)
+			if err != nil {
+				return diff, err
+			}
+			jb, err := json.Marshal(vb)
+			if err != nil {
+				return diff, err
+			}
+			if !bytes.Equal(ja, jb) {
+				d.A, d.B = va, vb
+				diff.Different = append(diff.Different, d)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// EncapsulatedDocumentLength is missing from the tag dictionary
+var encapsulatedDocumentLengthTag = tag.Tag{Group: 0x0042, Element: 0x0015}
+
+// inlineDocumentTypes are all a browser gets to show in place, the
+// mime type is whatever the uploader said so anything else, html or
+// svg say, would be running their scripts on our origin
+var inlineDocumentTypes = map[string]bool{
+	"application/pdf": true,
+}
+
+// encapsulatedDocument is the pdf, cda or the like an encapsulated
+// document instance carries, PS3.3 C.24.2
+type encapsulatedDocument struct {
+	mimeType string
+	data     []byte
+}
+
+// datasetDocument pulls the encapsulated document out of ds, if it has
+// one, without the padding byte values have to be evened out with
+func datasetDocument(ds dicom.Dataset) (doc encapsulatedDocument, ok bool) {
+	elem, err := ds.FindElementByTag(tag.EncapsulatedDocument)
+	if err != nil {
+		return
+	}
+	doc.data, ok = elem.Value.GetValue().([]byte)
+	if !ok || len(doc.data) == 0 {
+		return doc, false
+	}
+	if n, found := datasetInt(ds, encapsulatedDocumentLengthTag); found && n >= 0 && n < len(doc.data) {
+		doc.data = doc.data[:n]
+	}
+	doc.mimeType = "application/octet-stream"
+	if mt, params, err := mime.ParseMediaType(strings.TrimSpace(datasetString(ds, tag.MIMETypeOfEncapsulatedDocument))); err == nil {
+		doc.mimeType = mime.FormatMediaType(mt, params)
+	}
+	return
+}
+
+// disposition says whether doc can be shown in place, with name given
+// the extension its type usually has for when it's saved instead
+func (doc encapsulatedDocument) disposition(name string) string {
+	mt, _, _ := mime.ParseMediaType(doc.mimeType)
+	if exts, _ := mime.ExtensionsByType(mt); len(exts) > 0 && !strings.HasSuffix(strings.ToLower(name), exts[0]) {
+		name += exts[0]
+	}
+	disposition := "attachment"
+	if inlineDocumentTypes[mt] {
+		disposition = "inline"
+	}
+	return mime.FormatMediaType(disposition, map[string]string{"filename": name})
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDocument(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"report": "data/DOCUMENT/pdf.dcm"})
+
+	rec := send(h, http.MethodGet, "/report/document", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	if !bytes.HasPrefix(rec.Body.Bytes(), []byte("%PDF")) {
+		t.Errorf("body starts %q, not a pdf", rec.Body.Bytes()[:min(rec.Body.Len(), 8)])
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/pdf" {
+		t.Errorf("Content-Type %q", got)
+	}
+	if got := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(got, "inline") {
+		t.Errorf("pdf Content-Disposition %q, want inline", got)
+	}
+	if got := rec.Header().Get("X-Content-Type-Options"); got != "nosniff" {
+		t.Errorf("X-Content-Type-Options %q", got)
+	}
+
+	// whatever type the uploader gives that isn't on the list is only
+	// ever downloaded
+	if rec = send(h, http.MethodPatch, "/report/tag?name=MIMETypeOfEncapsulatedDocument", []byte(`{"value":"text/html"}`)); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH: got %d %s", rec.Code, rec.Body)
+	}
+	rec = send(h, http.MethodGet, "/report/document", nil)
+	if got := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(got, "attachment") {
+		t.Errorf("html Content-Disposition %q, want attachment", got)
+	}
+	if got := rec.Header().Get("X-Content-Type-Options"); got != "nosniff" {
+		t.Errorf("html X-Content-Type-Options %q", got)
+	}
+
+	if rec = send(h, http.MethodGet, "/missing/document", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("missing file: got %d", rec.Code)
+	}
+}
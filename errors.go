@@ -0,0 +1,48 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StatusError tags an error with the http status it should be
+// reported as
+type StatusError struct {
+	Code int
+	Err  error
+}
+
+func NewStatusError(code int, err error) error {
+	return &StatusError{Code: code, Err: err}
+}
+
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorHandler reports the last error a handler attached to the
+// context as json, defaulting to a 500 unless it's a StatusError
+func ErrorHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		err := ctx.Errors.Last()
+		if err == nil || ctx.Writer.Written() {
+			return
+		}
+
+		code := http.StatusInternalServerError
+		var serr *StatusError
+		if errors.As(err, &serr) {
+			code = serr.Code
+		}
+		// the request id lets a client point us at the right log line
+		ctx.JSON(code, gin.H{"error": err.Error(), "requestId": ctx.GetString(requestIDKey)})
+	}
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// etagCache remembers the content hash of each stored file alongside
+// the version it was taken at, any write changes that so a stale hash
+// is never handed out
+type etagCache struct {
+	mu   sync.Mutex
+	tags map[string]etagEntry
+}
+
+type etagEntry struct {
+	version string
+	etag    string
+}
+
+func newETagCache() *etagCache {
+	return &etagCache{tags: map[string]etagEntry{}}
+}
+
+func (c *etagCache) set(id string, info fs.FileInfo, h hash.Hash) string {
+	etag := `"` + hex.EncodeToString(h.Sum(nil)) + `"`
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tags[id] = etagEntry{fileVersion(info), etag}
+	return etag
+}
+
+// get gives the strong etag of file, hashing it if it's changed since
+// the last time or was never seen, the file is left at the start
+func (c *etagCache) get(id string, file *storedFile) (string, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	if etag, ok := c.peek(id, info); ok {
+		return etag, nil
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return c.set(id, info, h), nil
+}
+
+// peek gives the etag of id only if it's been worked out already for
+// the version of the file info describes
+func (c *etagCache) peek(id string, info fs.FileInfo) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.tags[id]
+	return e.etag, ok && e.version == fileVersion(info)
+}
+
+// quick is get without the hashing, for HEAD where reading the whole
+// file just to answer isn't worth it, a file that hasn't been hashed
+// yet gets a weak etag made from its version instead, s3's versions
+// come quoted already
+func (c *etagCache) quick(id string, file *storedFile) (string, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	if etag, ok := c.peek(id, info); ok {
+		return etag, nil
+	}
+	return `W/"` + strings.Trim(fileVersion(info), `"`) + `"`, nil
+}
+
+func (c *etagCache) remove(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tags, id)
+}
+
+// clear forgets every file, for when they may all have changed
+func (c *etagCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tags = map[string]etagEntry{}
+}
+
+// primeETag records the hash taken while id was being written so the
+// first GET doesn't have to read it all back, giving the etag
+func primeETag(ctx context.Context, storage fileStorage, c *etagCache, id string, h hash.Hash) string {
+	info, err := storage.Stat(ctx, id)
+	if err != nil {
+		return ""
+	}
+	return c.set(id, info, h)
+}
+
+// storedETag gives the etag of whatever's stored under id, hashing it
+// if need be
+func storedETag(ctx context.Context, storage fileStorage, c *etagCache, id string) (string, error) {
+	file, err := openFile(ctx, storage, id)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	return c.get(id, file)
+}
+
+// checkIfMatch fails with a 412 when the request carries If-Match and
+// none of its etags are the one stored under id, which has to be
+// checked under the id's lock to mean anything
+func checkIfMatch(ctx *gin.Context, storage fileStorage, c *etagCache, id string) error {
+	want := ctx.GetHeader("If-Match")
+	if want == "" {
+		return nil
+	}
+
+	etag, err := storedETag(ctx, storage, c, id)
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewStatusError(http.StatusPreconditionFailed, errors.New("nothing is stored under this id"))
+	}
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(want) == "*" {
+		return nil
+	}
+	// If-Match uses the strong comparison so weak etags never match
+	for _, cand := range strings.Split(want, ",") {
+		if strings.TrimSpace(cand) == etag {
+			return nil
+		}
+	}
+	ctx.Header("ETag", etag)
+	return NewStatusError(http.StatusPreconditionFailed, errors.New("the file has changed since the given etag"))
+}
+
+// renderETag is the etag of a frame rendered from whatever's stored
+// under id, rendering is deterministic so it comes down to the file's
+// version and how it was asked to be rendered, the version is all a
+// stat has to say so nothing gets hashed
+func renderETag(ctx context.Context, storage fileStorage, id string, opts imageOptions) (string, error) {
+	info, err := storage.Stat(ctx, id)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", NewStatusError(http.StatusNotFound, err)
+	}
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	fmt.Fprintln(h, id, fileVersion(info), opts.enc.contentType, opts.enc.quality, opts.frame, opts.maxDim, opts.overlays, opts.rescale, opts.dataURI, opts.cmap)
+	if opts.crop != nil {
+		fmt.Fprintln(h, "crop", *opts.crop)
+	}
+	if opts.win != nil {
+		fmt.Fprintln(h, "window", opts.win.center, opts.win.width)
+	} else {
+		// files without a window of their own fall back on these
+		fmt.Fprintln(h, "modality windows", presets.Modalities)
+	}
+	if opts.inverted != nil {
+		fmt.Fprintln(h, "invert", *opts.inverted)
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)) + `"`, nil
+}
+
+// notModified is whether the request's If-None-Match already has etag,
+// which uses the weak comparison unlike If-Match
+func notModified(ctx *gin.Context, etag string) bool {
+	want := ctx.GetHeader("If-None-Match")
+	if want == "" {
+		return false
+	}
+	if strings.TrimSpace(want) == "*" {
+		return true
+	}
+	for _, cand := range strings.Split(want, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(cand), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// imageCacheHeaders lets shared caches keep rendered images too,
+// unless auth is on and they'd be handing them out to anyone, the
+// format can come from Accept so caches have to keep them apart by it
+func imageCacheHeaders(ctx *gin.Context) {
+	scope := "public"
+	if *authToken != "" {
+		scope = "private"
+	}
+	ctx.Header("Cache-Control", fmt.Sprintf("%s, max-age=%d", scope, int(imageMaxAge.Seconds())))
+	ctx.Writer.Header().Add("Vary", "Accept")
+}
+
+// checkOverwrite fails with a 409 when the request carries
+// If-None-Match: * or ?overwrite=false and there's something stored
+// under id already, which like If-Match needs the id's lock held
+func checkOverwrite(ctx *gin.Context, storage fileStorage, id string) error {
+	if strings.TrimSpace(ctx.GetHeader("If-None-Match")) != "*" && ctx.Query("overwrite") != "false" {
+		return nil
+	}
+
+	_, err := storage.Stat(ctx, id)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return NewStatusError(http.StatusConflict, errors.New("a file is already stored under this id"))
+}
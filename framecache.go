@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// frameCache keeps fully parsed datasets, pixel data and all, for the
+// files most recently rendered so stepping through the frames of one
+// doesn't reparse it for each, it's bounded by an estimate of the
+// memory the frames take up rather than a count since one file can
+// hold thousands of them
+type frameCache struct {
+	*lruCache[cachedFrames]
+}
+
+type cachedFrames struct {
+	ds     dicom.Dataset
+	frames []*frame.Frame
+}
+
+func newFrameCache(max int64) *frameCache {
+	c := newLRUCache(max, func(cf cachedFrames) (size int64) {
+		for _, f := range cf.frames {
+			size += frameSize(f)
+		}
+		return
+	})
+	c.gauge = frameCacheBytes
+	return &frameCache{c}
+}
+
+// get gives the dataset and frames cached for id, on a miss it gives
+// the generation to hand back to add once the file's been parsed
+func (c *frameCache) get(id string) (ds dicom.Dataset, frames []*frame.Frame, gen uint64, ok bool) {
+	cf, gen, ok := c.lruCache.get(id)
+	if !ok {
+		frameCacheMisses.Inc()
+		return ds, nil, gen, false
+	}
+	frameCacheHits.Inc()
+	return cf.ds, cf.frames, 0, true
+}
+
+// add caches ds for id if it has frames at all
+func (c *frameCache) add(id string, gen uint64, ds dicom.Dataset) {
+	frames := datasetFrames(ds)
+	if frames == nil {
+		return
+	}
+	c.lruCache.add(id, gen, cachedFrames{ds, frames})
+}
+
+// load gives the dataset and frames stored under id, parsing the
+// whole file when they aren't cached
+func (c *frameCache) load(ctx context.Context, storage fileStorage, id string) (dicom.Dataset, []*frame.Frame, error) {
+	ds, frames, gen, ok := c.get(id)
+	if ok {
+		return ds, frames, nil
+	}
+	file, err := openFile(ctx, storage, id)
+	if err != nil {
+		return ds, nil, err
+	}
+	defer file.Close()
+	release, err := parsers.acquire(ctx)
+	if err != nil {
+		return ds, nil, err
+	}
+	defer release()
+
+	ds, err = dicom.ParseUntilEOF(contextReader{ctx, file}, nil, parseOptions()...)
+	if err != nil {
+		if ctx.Err() == nil {
+			parseFailures.Inc()
+		}
+		return ds, nil, pixelDataError(err)
+	}
+	c.add(id, gen, ds)
+	return ds, datasetFrames(ds), nil
+}
+
+// datasetFrames gives the frames of ds's pixel data, nil if it has
+// none or they were skipped
+func datasetFrames(ds dicom.Dataset) []*frame.Frame {
+	elem, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil || elem.Value.ValueType() != dicom.PixelData {
+		return nil
+	}
+	return dicom.MustGetPixelDataInfo(elem.Value).Frames
+}
+
+// frameSize estimates what a frame holds on to, native frames keep
+// every sample as an int in a slice per pixel so they're far bigger
+// than the pixel data they came from
+func frameSize(f *frame.Frame) int64 {
+	if f.Encapsulated {
+		return int64(len(f.EncapsulatedData.Data))
+	}
+	samples := 1
+	if len(f.NativeData.Data) > 0 {
+		samples = len(f.NativeData.Data[0])
+	}
+	return int64(len(f.NativeData.Data)) * int64(24+8*samples)
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// responses smaller than this aren't worth the cpu to compress
+const gzipMinSize = 1400
+
+// content types worth compressing, images are compressed already and
+// raw dicom gets served with byte ranges which don't mix with gzip
+var gzipTypes = []string{"application/json", "application/dicom+json", "text/"}
+
+// Gzip compresses large enough responses for clients that accept it
+func Gzip() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.Request.Method == http.MethodHead || !acceptsGzip(ctx.GetHeader("Accept-Encoding")) {
+			ctx.Next()
+			return
+		}
+
+		w := &gzipWriter{ResponseWriter: ctx.Writer}
+		ctx.Writer = w
+		defer w.finish()
+		ctx.Next()
+	}
+}
+
+// acceptsGzip reads Accept-Encoding for whether gzip is wanted, it
+// has to be listed or covered by * with a q above 0, q=0 refuses it
+func acceptsGzip(header string) bool {
+	gz, star := -1.0, -1.0
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if v, ok := strings.CutPrefix(strings.ToLower(strings.TrimSpace(param)), "q="); ok {
+				// a q that doesn't parse isn't taken as a yes
+				q, _ = strconv.ParseFloat(v, 64)
+			}
+		}
+		switch strings.ToLower(strings.TrimSpace(coding)) {
+		case "gzip", "x-gzip":
+			gz = q
+		case "*":
+			star = q
+		}
+	}
+	if gz >= 0 {
+		return gz > 0
+	}
+	return star > 0
+}
+
+// gzipWriter holds back the start of a response until it knows
+// whether compressing it will pay off
+type gzipWriter struct {
+	gin.ResponseWriter
+	buf     []byte
+	decided bool
+	gz      *gzip.Writer
+}
+
+func (w *gzipWriter) Write(p []byte) (int, error) {
+	if !w.decided && len(w.buf)+len(p) < gzipMinSize {
+		w.buf = append(w.buf, p...)
+		return len(p), nil
+	}
+	if !w.decided {
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+	}
+	if w.gz != nil {
+		return w.gz.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *gzipWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// held back bytes still count as written so nothing else tries to
+// start a response on top of them
+func (w *gzipWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+func (w *gzipWriter) Flush() {
+	if !w.decided {
+		w.decide(true)
+	}
+	if w.gz != nil {
+		w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// decide commits to compressing or not and sends what was held back
+func (w *gzipWriter) decide(big bool) error {
+	w.decided = true
+
+	h := w.Header()
+	ct := h.Get("Content-Type")
+	for _, t := range gzipTypes {
+		if !strings.HasPrefix(ct, t) {
+			continue
+		}
+		h.Add("Vary", "Accept-Encoding")
+		if big && h.Get("Content-Encoding") == "" {
+			h.Set("Content-Encoding", "gzip")
+			h.Del("Content-Length")
+			w.gz = gzip.NewWriter(w.ResponseWriter)
+		}
+		break
+	}
+
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func (w *gzipWriter) finish() {
+	if !w.decided {
+		w.decide(false)
+	}
+	if w.gz != nil {
+		w.gz.Close()
+	}
+}
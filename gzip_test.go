@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestAcceptsGzip(t *testing.T) {
+	for header, want := range map[string]bool{
+		"":                     false,
+		"gzip":                 true,
+		"deflate, gzip":        true,
+		"gzip;q=0":             false,
+		"gzip; q=0.0, deflate": false,
+		"GZIP;Q=0.5":           true,
+		"*":                    true,
+		"*;q=0":                false,
+		"gzip;q=0, *":          false,
+		"br, *;q=0.1":          true,
+		"identity":             false,
+		"gzip;q=nope":          false,
+	} {
+		if got := acceptsGzip(header); got != want {
+			t.Errorf("%q: got %v, want %v", header, got, want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/suyashkumar/dicom/pkg/frame"
+)
+
+// bins a histogram gets unless it asks for some other number, and the
+// most it can ask for
+const (
+	defaultHistogramBins = 256
+	maxHistogramBins     = 65536
+)
+
+// histogram is the spread of a frame's stored pixel values, bin i
+// counts the values from min+i*binWidth up to the next bin
+type histogram struct {
+	Frame    int     `json:"frame"`
+	Count    int     `json:"count"`
+	Min      int     `json:"min"`
+	Max      int     `json:"max"`
+	Mean     float64 `json:"mean"`
+	BinWidth float64 `json:"binWidth"`
+	Bins     []int   `json:"bins"`
+}
+
+// frameHistogram counts the values of f into bins spread evenly from
+// its lowest value to its highest, every sample of every pixel counts
+// so color frames get all their channels pooled, signed pixel data has
+// to be said so since the parser reads every value as unsigned
+func frameHistogram(f frame.NativeFrame, signed bool, bins int) histogram {
+	h := histogram{Bins: make([]int, bins)}
+	value := func(v int) int {
+		if signed && f.BitsPerSample > 1 && v >= 1<<(f.BitsPerSample-1) {
+			v -= 1 << f.BitsPerSample
+		}
+		return v
+	}
+
+	var sum float64
+	for _, px := range f.Data {
+		for _, s := range px {
+			v := value(s)
+			if h.Count == 0 || v < h.Min {
+				h.Min = v
+			}
+			if h.Count == 0 || v > h.Max {
+				h.Max = v
+			}
+			sum += float64(v)
+			h.Count++
+		}
+	}
+	if h.Count == 0 {
+		return h
+	}
+	h.Mean = sum / float64(h.Count)
+
+	h.BinWidth = float64(h.Max-h.Min+1) / float64(bins)
+	for _, px := range f.Data {
+		for _, s := range px {
+			i := int(float64(value(s)-h.Min) / h.BinWidth)
+			h.Bins[min(i, bins-1)]++
+		}
+	}
+	return h
+}
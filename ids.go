@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ids are file names in storage so only characters that are safe in
+// any file name are allowed, which still fits uids, uuids and the
+// usual name.dcm
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// validID checks id could be a stored file, leading dots are kept
+// for our own scratch files which also rules out . and ..
+func validID(id string) error {
+	if !idPattern.MatchString(id) {
+		return fmt.Errorf("invalid id %q, ids are up to 128 letters, digits, dots, dashes and underscores", id)
+	}
+	if strings.HasPrefix(id, ".") {
+		return errors.New("ids can't start with a dot")
+	}
+	return nil
+}
+
+// ValidateID turns away requests whose route has an :id that couldn't
+// name a stored file, before any handler gets confused by it
+func ValidateID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, ok := ctx.Params.Get("id")
+		if !ok {
+			return
+		}
+		if err := validID(id); err != nil {
+			ctx.Error(NewStatusError(http.StatusBadRequest, err))
+			ctx.Abort()
+		}
+	}
+}
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"golang.org/x/image/draw"
+)
+
+// imageEncoder renders a decoded frame into some wire format, raw
+// ones skip decoding and send the frame's pixel values as they are
+type imageEncoder struct {
+	contentType string
+	encode      func(io.Writer, image.Image) error
+	raw         bool
+	// jpeg quality, which changes the output without changing the type
+	quality int
+}
+
+// negotiateEncoder picks the output format from ?format= or failing
+// that the Accept header, sticking with png when neither says
+// otherwise
+func negotiateEncoder(ctx *gin.Context) (enc imageEncoder, err error) {
+	format, ok := ctx.GetQuery("format")
+	if !ok {
+		format = ctx.NegotiateFormat("image/png", "image/jpeg")
+		if format == "" {
+			format = ctx.GetHeader("Accept")
+		}
+	}
+	return formatEncoder(ctx, format, "quality")
+}
+
+// formatEncoder is the encoder for format, jpeg takes its quality
+// from the query parameter named by qualityKey
+func formatEncoder(ctx *gin.Context, format, qualityKey string) (enc imageEncoder, err error) {
+	switch format {
+	case "png", "image/png":
+		enc = imageEncoder{contentType: "image/png", encode: png.Encode}
+	case "jpeg", "jpg", "image/jpeg":
+		quality, err := queryInt(ctx, qualityKey, 85)
+		if err != nil {
+			return enc, err
+		}
+		if quality < 1 || quality > 100 {
+			return enc, NewStatusError(http.StatusBadRequest, errors.New("quality must be between 1 and 100"))
+		}
+
+		enc = imageEncoder{contentType: "image/jpeg", quality: quality, encode: func(w io.Writer, img image.Image) error {
+			return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+		}}
+	case "raw", "application/octet-stream":
+		enc = imageEncoder{contentType: "application/octet-stream", raw: true}
+	default:
+		err = NewStatusError(http.StatusNotAcceptable, fmt.Errorf("unsupported image format %q", format))
+	}
+	return
+}
+
+// imageOptions say how a frame gets rendered
+type imageOptions struct {
+	enc      imageEncoder
+	frame    int
+	win      *window
+	maxDim   int
+	cmap     color.Palette
+	overlays bool
+	// rescale puts stored values through the modality lut before
+	// windowing, off to window the values as they're stored
+	rescale bool
+	// region of the frame to send rather than all of it, nil for the
+	// whole frame
+	crop *image.Rectangle
+	// dataURI wraps the encoded image up in json as a data uri
+	dataURI bool
+	// left nil to go by the photometric interpretation, set to
+	// override it for files that get it wrong
+	inverted *bool
+}
+
+// queryImageOptions reads the rendering options off the query
+func queryImageOptions(ctx *gin.Context) (opts imageOptions, err error) {
+	opts.enc, err = negotiateEncoder(ctx)
+	if err != nil {
+		return
+	}
+	opts.frame, err = queryInt(ctx, "frame", 0)
+	if err != nil {
+		return
+	}
+	opts.win, err = queryWindow(ctx)
+	if err != nil {
+		return
+	}
+	opts.win, err = queryPreset(ctx, opts.win)
+	if err != nil {
+		return
+	}
+	opts.maxDim, err = queryInt(ctx, "maxDim", 0)
+	if err != nil {
+		return
+	}
+	opts.crop, err = queryCrop(ctx)
+	if err != nil {
+		return
+	}
+	if opts.crop != nil && opts.enc.raw {
+		return opts, NewStatusError(http.StatusBadRequest, errors.New("raw frames can't be cropped"))
+	}
+	opts.dataURI, err = queryDataURI(ctx)
+	if err != nil {
+		return
+	}
+	if opts.dataURI && opts.enc.raw {
+		return opts, NewStatusError(http.StatusBadRequest, errors.New("raw frames can't be sent as a data uri"))
+	}
+	opts.cmap, err = queryColormap(ctx)
+	if err != nil {
+		return
+	}
+	opts.overlays = ctx.Query("overlays") == "true"
+	opts.rescale, err = strconv.ParseBool(ctx.DefaultQuery("rescale", "true"))
+	if err != nil {
+		return opts, NewStatusError(http.StatusBadRequest, fmt.Errorf("invalid rescale: %w", err))
+	}
+	if v, ok := ctx.GetQuery("invert"); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, NewStatusError(http.StatusBadRequest, fmt.Errorf("invalid invert: %w", err))
+		}
+		opts.inverted = &b
+	}
+	return
+}
+
+// queryDataURI is whether ?encoding= asks for the image inlined in
+// json, the only other encoding is the image as it is
+func queryDataURI(ctx *gin.Context) (bool, error) {
+	switch encoding := ctx.Query("encoding"); encoding {
+	case "":
+		return false, nil
+	case "dataURI":
+		return true, nil
+	default:
+		return false, NewStatusError(http.StatusBadRequest, fmt.Errorf("unsupported encoding %q", encoding))
+	}
+}
+
+// writeDataURI encodes img as {"image": "data:..."} so a page can put
+// it straight into an img tag, base64 never needs escaping in json
+func writeDataURI(w io.Writer, enc imageEncoder, img image.Image) error {
+	if _, err := fmt.Fprintf(w, `{"image":"data:%s;base64,`, enc.contentType); err != nil {
+		return err
+	}
+	b64 := base64.NewEncoder(base64.StdEncoding, w)
+	if err := enc.encode(b64, img); err != nil {
+		return err
+	}
+	if err := b64.Close(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, `"}`)
+	return err
+}
+
+// writeRawFrame sends the pixel values of a native frame as little
+// endian samples as wide as they're allocated, with the layout in the
+// headers
+func writeRawFrame(ctx *gin.Context, f *frame.Frame) error {
+	if f.Encapsulated {
+		return NewStatusError(http.StatusUnprocessableEntity, errors.New("raw export needs native pixel data, transcode the file first"))
+	}
+	nf := f.NativeData
+	width := nf.BitsPerSample / 8
+	if width != 1 && width != 2 && width != 4 {
+		return NewStatusError(http.StatusUnprocessableEntity, fmt.Errorf("can't export %d bit samples", nf.BitsPerSample))
+	}
+	samples := 1
+	if len(nf.Data) > 0 {
+		samples = len(nf.Data[0])
+	}
+
+	ctx.Header("X-Rows", strconv.Itoa(nf.Rows))
+	ctx.Header("X-Columns", strconv.Itoa(nf.Cols))
+	ctx.Header("X-Bits-Allocated", strconv.Itoa(nf.BitsPerSample))
+	ctx.Header("X-Samples-Per-Pixel", strconv.Itoa(samples))
+	ctx.Header("Content-Length", strconv.Itoa(len(nf.Data)*samples*width))
+	ctx.Header("Content-Type", "application/octet-stream")
+	ctx.Status(http.StatusOK)
+
+	w := bufio.NewWriter(ctx.Writer)
+	buf := make([]byte, 4)
+	for _, px := range nf.Data {
+		for _, v := range px {
+			binary.LittleEndian.PutUint32(buf, uint32(v))
+			if _, err := w.Write(buf[:width]); err != nil {
+				return err
+			}
+		}
+	}
+	return w.Flush()
+}
+
+// seekFrame reads frames off the parser until it reaches index n,
+// giving back nil and the number of frames seen if it runs dry first
+func seekFrame(ctx context.Context, frames <-chan *frame.Frame, n int) (*frame.Frame, int, error) {
+	for i := 0; ; i++ {
+		select {
+		case <-ctx.Done():
+			return nil, i, ctx.Err()
+		case f, ok := <-frames:
+			if !ok {
+				return nil, i, nil
+			}
+			if i == n {
+				return f, i, nil
+			}
+		}
+	}
+}
+
+// window is a voi lut window in the units the modality lut puts
+// pixels in, which is what the file's own windows are given in
+type window struct {
+	center, width float64
+}
+
+// queryWindow reads an explicit window off ?windowCenter= and
+// ?windowWidth=, which have to be given together
+func queryWindow(ctx *gin.Context) (*window, error) {
+	center, cok, err := queryFloat(ctx, "windowCenter")
+	if err != nil {
+		return nil, err
+	}
+	width, wok, err := queryFloat(ctx, "windowWidth")
+	if err != nil {
+		return nil, err
+	}
+	if cok != wok {
+		return nil, NewStatusError(http.StatusBadRequest, errors.New("windowCenter and windowWidth must be given together"))
+	}
+	if !cok {
+		return nil, nil
+	}
+	if width < 1 {
+		return nil, NewStatusError(http.StatusBadRequest, errors.New("windowWidth must be at least 1"))
+	}
+	return &window{center, width}, nil
+}
+
+// datasetWindow falls back to the first window the file itself
+// suggests, if any
+func datasetWindow(ds dicom.Dataset) *window {
+	center, cok := datasetFloat(ds, tag.WindowCenter)
+	width, wok := datasetFloat(ds, tag.WindowWidth)
+	if !cok || !wok || width < 1 {
+		return nil
+	}
+	return &window{center, width}
+}
+
+// geometryHeaders passes along where the frame sits in space, as
+// DICOM writes them with values split by backslashes, so clients
+// reconstructing volumes don't need a trip to the tag endpoint
+func geometryHeaders(ctx *gin.Context, ds dicom.Dataset) {
+	for h, t := range map[string]tag.Tag{
+		"X-Pixel-Spacing":     tag.PixelSpacing,
+		"X-Image-Orientation": tag.ImageOrientationPatient,
+	} {
+		elem, err := ds.FindElementByTag(t)
+		if err != nil {
+			continue
+		}
+		vals, ok := elem.Value.GetValue().([]string)
+		if !ok || len(vals) == 0 {
+			continue
+		}
+		trimmed := make([]string, len(vals))
+		for i, v := range vals {
+			trimmed[i] = strings.TrimSpace(v)
+		}
+		ctx.Header(h, strings.Join(trimmed, `\`))
+	}
+}
+
+// datasetFloat reads the first value of a numeric string element
+func datasetFloat(ds dicom.Dataset, t tag.Tag) (float64, bool) {
+	elem, err := ds.FindElementByTag(t)
+	if err != nil {
+		return 0, false
+	}
+	vals, ok := elem.Value.GetValue().([]string)
+	if !ok || len(vals) == 0 {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(vals[0]), 64)
+	return f, err == nil
+}
+
+// modality is the linear modality lut of PS3.3 C.11.1, turning
+// stored values into something like hounsfield units
+type modality struct {
+	slope, intercept float64
+	// signed values come out of the parser as their raw bits, so they
+	// need sign extending from the bits stored first
+	signed bool
+	bits   int
+}
+
+// rawModality leaves stored values as they are
+var rawModality = modality{slope: 1}
+
+// datasetModality reads the rescale off ds, files without one keep
+// their stored values
+func datasetModality(ds dicom.Dataset) modality {
+	m := rawModality
+	if slope, ok := datasetFloat(ds, tag.RescaleSlope); ok && slope != 0 {
+		m.slope = slope
+	}
+	m.intercept, _ = datasetFloat(ds, tag.RescaleIntercept)
+	signed, _ := datasetInt(ds, tag.PixelRepresentation)
+	m.signed = signed == 1
+	m.bits, _ = datasetInt(ds, tag.BitsStored)
+	return m
+}
+
+// value is the stored value v after the lut
+func (m modality) value(v uint16) float64 {
+	s := int(v)
+	if m.signed && m.bits > 0 && m.bits <= 16 {
+		s &= 1<<m.bits - 1
+		if s >= 1<<(m.bits-1) {
+			s -= 1 << m.bits
+		}
+	}
+	return m.slope*float64(s) + m.intercept
+}
+
+// apply maps the window linearly onto 8 bit grayscale as laid out in
+// PS3.3 C.11.2.1.2, after putting the stored values through m, only
+// raw grayscale frames carry values in the units the window is given
+// in, 8 bit ones as much as 16, so anything else passes through
+func (w *window) apply(img image.Image, m modality) image.Image {
+	var stored func(x, y int) uint16
+	switch gray := img.(type) {
+	case *image.Gray16:
+		stored = func(x, y int) uint16 { return gray.Gray16At(x, y).Y }
+	case *image.Gray:
+		stored = func(x, y int) uint16 { return uint16(gray.GrayAt(x, y).Y) }
+	default:
+		return img
+	}
+
+	lo := w.center - 0.5 - (w.width-1)/2
+	bounds := img.Bounds()
+	out := image.NewGray(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			v := (m.value(stored(x, y)) - lo) / max(w.width-1, 1)
+			out.SetGray(x, y, color.Gray{Y: uint8(math.Round(255 * min(max(v, 0), 1)))})
+		}
+	}
+	return out
+}
+
+// queryCrop reads a region off ?x=&y=&w=&h=, which have to be given
+// all together, whether it fits the frame is only known once it's
+// decoded
+func queryCrop(ctx *gin.Context) (*image.Rectangle, error) {
+	var vals [4]int
+	given := 0
+	for i, key := range []string{"x", "y", "w", "h"} {
+		if _, ok := ctx.GetQuery(key); !ok {
+			continue
+		}
+		v, err := queryInt(ctx, key, 0)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = v
+		given++
+	}
+	switch given {
+	case 0:
+		return nil, nil
+	case 4:
+	default:
+		return nil, NewStatusError(http.StatusBadRequest, errors.New("x, y, w and h must be given together"))
+	}
+	x, y, w, h := vals[0], vals[1], vals[2], vals[3]
+	if w < 1 || h < 1 {
+		return nil, NewStatusError(http.StatusBadRequest, errors.New("w and h must be at least 1"))
+	}
+	r := image.Rect(x, y, x+w, y+h)
+	return &r, nil
+}
+
+// crop cuts r out of img, which has to lie within it
+func crop(img image.Image, r image.Rectangle) (image.Image, error) {
+	b := img.Bounds()
+	if !r.Add(b.Min).In(b) {
+		return nil, NewStatusError(http.StatusBadRequest, fmt.Errorf("region %v is outside the %dx%d frame", r, b.Dx(), b.Dy()))
+	}
+	sub, ok := img.(interface {
+		SubImage(image.Rectangle) image.Image
+	})
+	if !ok {
+		return nil, fmt.Errorf("can't crop a %T", img)
+	}
+	return sub.SubImage(r.Add(b.Min)), nil
+}
+
+// thumbnail scales img down so neither side is longer than maxDim,
+// keeping its aspect ratio, images already small enough are left as
+// they are
+func thumbnail(img image.Image, maxDim int) image.Image {
+	b := img.Bounds()
+	longest := max(b.Dx(), b.Dy())
+	if longest <= maxDim {
+		return img
+	}
+
+	w := max(b.Dx()*maxDim/longest, 1)
+	h := max(b.Dy()*maxDim/longest, 1)
+	rect := image.Rect(0, 0, w, h)
+	var dst draw.Image
+	switch img.(type) {
+	case *image.Gray:
+		dst = image.NewGray(rect)
+	case *image.Gray16:
+		dst = image.NewGray16(rect)
+	default:
+		dst = image.NewRGBA(rect)
+	}
+	draw.CatmullRom.Scale(dst, rect, img, b, draw.Src, nil)
+	return dst
+}
+
+// invert flips grayscale polarity in place, which MONOCHROME1 needs
+// since it stores the lowest value as white, raw 16 bit frames flip
+// within the bits actually stored
+func invert(img image.Image, bitsStored int) image.Image {
+	switch gray := img.(type) {
+	case *image.Gray:
+		for i, v := range gray.Pix {
+			gray.Pix[i] = 0xff - v
+		}
+	case *image.Gray16:
+		top := uint16(1<<min(max(bitsStored, 1), 16) - 1)
+		for y := gray.Rect.Min.Y; y < gray.Rect.Max.Y; y++ {
+			for x := gray.Rect.Min.X; x < gray.Rect.Max.X; x++ {
+				v := gray.Gray16At(x, y).Y
+				gray.SetGray16(x, y, color.Gray16{Y: top - min(v, top)})
+			}
+		}
+	}
+	return img
+}
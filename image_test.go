@@ -0,0 +1,246 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWindowAppliesToGray8(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 3, 1))
+	for x, v := range []uint8{80, 100, 120} {
+		img.SetGray(x, 0, color.Gray{Y: v})
+	}
+
+	out, ok := (&window{center: 100, width: 21}).apply(img, rawModality).(*image.Gray)
+	if !ok {
+		t.Fatal("8 bit frame wasn't windowed")
+	}
+	for x, want := range []uint8{0, 134, 255} {
+		if got := out.GrayAt(x, 0).Y; got != want {
+			t.Errorf("pixel %d: got %d, want %d", x, got, want)
+		}
+	}
+}
+
+// serveWithin fails t if h takes longer than a few seconds over req,
+// then waits for whatever goroutines it started to be gone too
+func serveWithin(t *testing.T, h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	serveToWithin(t, h, rec, req)
+	return rec
+}
+
+// serveToWithin is serveWithin writing to w
+func serveToWithin(t *testing.T, h http.Handler, w http.ResponseWriter, req *http.Request) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.ServeHTTP(w, req)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s %s still going after 5s", req.Method, req.URL)
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); runtime.NumGoroutine() > before; {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines left behind", runtime.NumGoroutine()-before)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestImageCancelledReturnsPromptly(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"base": xrayFixture})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodGet, "/base/image", nil).WithContext(ctx)
+	if rec := serveWithin(t, h, req); rec.Code == http.StatusOK {
+		t.Errorf("cancelled request got a 200")
+	}
+}
+
+func TestImageCaching(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"base": xrayFixture})
+
+	rec := send(h, http.MethodGet, "/base/image", nil, "Accept", "image/png")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	if vary := rec.Header().Values("Vary"); !slices.Contains(vary, "Accept") {
+		t.Errorf("Vary %q doesn't have Accept", vary)
+	}
+	etag := rec.Header().Get("ETag")
+	if rec = send(h, http.MethodGet, "/base/image", nil, "Accept", "image/png", "If-None-Match", etag); rec.Code != http.StatusNotModified {
+		t.Errorf("revalidating: got %d", rec.Code)
+	}
+	if rec = send(h, http.MethodGet, "/base/image", nil, "Accept", "image/jpeg", "If-None-Match", etag); rec.Code != http.StatusOK {
+		t.Errorf("another format with the png's etag: got %d", rec.Code)
+	}
+	if rec = send(h, http.MethodGet, "/nothing/image", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("nothing stored: got %d", rec.Code)
+	}
+}
+
+func TestImageFrameErrorReturnsPromptly(t *testing.T) {
+	// an end of image marker straight after the start leaves the only
+	// frame parsing fine and failing to decode
+	data := readFixture(t, "data/COLOR/rgb-jpeg.dcm")
+	soi := bytes.Index(data, []byte{0xff, 0xd8, 0xff})
+	if soi < 0 {
+		t.Fatal("no jpeg in the fixture")
+	}
+	data[soi+2], data[soi+3] = 0xff, 0xd9
+	h, storage := newTestRouter(t, nil)
+	storage.put("bad", data)
+
+	rec := serveWithin(t, h, httptest.NewRequest(http.MethodGet, "/bad/image", nil))
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("got %d %s, want 422", rec.Code, rec.Body)
+	}
+}
+
+// grayAt decodes the png in rec and gives the pixel at x, y
+func grayAt(t *testing.T, rec *httptest.ResponseRecorder, x, y int) uint16 {
+	t.Helper()
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	img, err := png.Decode(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return color.Gray16Model.Convert(img.At(x, y)).(color.Gray16).Y
+}
+
+func TestImageMonochrome1(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"base": xrayFixture})
+	// near the middle of the image, away from the black border
+	const x, y = 512, 512
+	mono2 := grayAt(t, send(h, http.MethodGet, "/base/image", nil), x, y)
+	if got := grayAt(t, send(h, http.MethodGet, "/base/image?invert=true", nil), x, y); got != 0xffff-mono2 {
+		t.Errorf("MONOCHROME2 inverted: got %d, want %d", got, 0xffff-mono2)
+	}
+
+	if rec := send(h, http.MethodPatch, "/base/tag?name=PhotometricInterpretation", []byte(`{"value":"MONOCHROME1"}`)); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH: got %d %s", rec.Code, rec.Body)
+	}
+	if got := grayAt(t, send(h, http.MethodGet, "/base/image", nil), x, y); got != 0xffff-mono2 {
+		t.Errorf("MONOCHROME1: got %d, want %d", got, 0xffff-mono2)
+	}
+	if got := grayAt(t, send(h, http.MethodGet, "/base/image?invert=false", nil), x, y); got != mono2 {
+		t.Errorf("MONOCHROME1 with invert=false: got %d, want %d", got, mono2)
+	}
+}
+
+func TestImageColor(t *testing.T) {
+	fixtures := map[string]string{
+		"rgb":    "data/COLOR/rgb.dcm",
+		"planar": "data/COLOR/rgb-planar.dcm",
+		"jpeg":   "data/COLOR/rgb-jpeg.dcm",
+		"ybr":    "data/COLOR/ybr-full.dcm",
+		"ybr422": "data/COLOR/ybr-full-422.dcm",
+	}
+	h, _ := newTestRouter(t, fixtures)
+
+	// each fixture is the same 64x64 image cut into red, green, blue
+	// and yellow quarters, ybr and jpeg can be off by a little
+	quarters := []struct {
+		x, y    int
+		r, g, b uint32
+	}{
+		{16, 16, 0xff, 0, 0},
+		{48, 16, 0, 0xff, 0},
+		{16, 48, 0, 0, 0xff},
+		{48, 48, 0xff, 0xff, 0},
+	}
+	near := func(a, b uint32) bool { return max(a, b)-min(a, b) <= 4 }
+	for id := range fixtures {
+		rec := send(h, http.MethodGet, "/"+id+"/image", nil)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: got %d %s", id, rec.Code, rec.Body)
+		}
+		img, err := png.Decode(rec.Body)
+		if err != nil {
+			t.Fatalf("%s: %v", id, err)
+		}
+		for _, q := range quarters {
+			r, g, b, _ := img.At(q.x, q.y).RGBA()
+			if !near(r>>8, q.r) || !near(g>>8, q.g) || !near(b>>8, q.b) {
+				t.Errorf("%s at %d,%d: got %d,%d,%d, want %d,%d,%d", id, q.x, q.y, r>>8, g>>8, b>>8, q.r, q.g, q.b)
+			}
+		}
+	}
+}
+
+func TestImageTruncatedPixelData(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{
+		"base":      xrayFixture,
+		"truncated": "data/CORRUPT/truncated-pixels.dcm",
+	})
+
+	rec := send(h, http.MethodGet, "/truncated/image", nil)
+	var body struct {
+		Error string `json:"error"`
+	}
+	decodeJSON(t, rec, &body)
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(body.Error, "truncated") {
+		t.Errorf("got %d %s, want a 422 saying it's truncated", rec.Code, rec.Body)
+	}
+	if rec = send(h, http.MethodGet, "/base/image", nil); rec.Code != http.StatusOK {
+		t.Errorf("good file afterwards: got %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestImageParserErrorAfterFrame(t *testing.T) {
+	// the pixel data is whole but the file stops partway through the
+	// element after it, so the parser only fails once the frame's out
+	h, _ := newTestRouter(t, map[string]string{"truncated": "data/CORRUPT/truncated-after-pixels.dcm"})
+
+	rec := serveWithin(t, h, httptest.NewRequest(http.MethodGet, "/truncated/image", nil))
+	if rec.Code == http.StatusOK {
+		t.Errorf("got a 200 with %d bytes", rec.Body.Len())
+	}
+}
+
+// disconnectingWriter is a client that goes away as soon as the
+// response starts arriving
+type disconnectingWriter struct {
+	*httptest.ResponseRecorder
+	cancel  context.CancelFunc
+	written int
+}
+
+func (w *disconnectingWriter) Write(p []byte) (int, error) {
+	w.cancel()
+	w.written += len(p)
+	return len(p), nil
+}
+
+func TestImageStopsWhenClientDisconnects(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"base": xrayFixture})
+	full := send(h, http.MethodGet, "/base/image", nil).Body.Len()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &disconnectingWriter{ResponseRecorder: httptest.NewRecorder(), cancel: cancel}
+	serveToWithin(t, h, w, httptest.NewRequest(http.MethodGet, "/base/image", nil).WithContext(ctx))
+	if w.written >= full/2 {
+		t.Errorf("wrote %d of the image's %d bytes after the client left", w.written, full)
+	}
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"io/fs"
+	"log"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// instance is where a stored file sits in the study hierarchy, along
+// with enough description to tell its study and series apart
+type instance struct {
+	ID     string
+	Study  string
+	Series string
+	SOP    string
+
+	StudyDescription  string
+	SeriesDescription string
+	Modality          string
+	InstanceNumber    string
+	StudyDate         string
+	PatientID         string
+}
+
+// uidIndex maps dicom uids back to the ids files are stored under,
+// it only lives in memory and gets rebuilt from storage on startup
+type uidIndex struct {
+	mu   sync.RWMutex
+	ids  map[string]instance
+	sops map[string]string
+}
+
+func newUIDIndex() *uidIndex {
+	return &uidIndex{
+		ids:  map[string]instance{},
+		sops: map[string]string{},
+	}
+}
+
+// add records the file stored under id, replacing whatever was
+// there before, anything without a SOPInstanceUID can't be indexed
+func (x *uidIndex) add(id string, ds dicom.Dataset) {
+	inst := instance{
+		ID:     id,
+		Study:  datasetString(ds, tag.StudyInstanceUID),
+		Series: datasetString(ds, tag.SeriesInstanceUID),
+		SOP:    datasetString(ds, tag.SOPInstanceUID),
+
+		StudyDescription:  datasetString(ds, tag.StudyDescription),
+		SeriesDescription: datasetString(ds, tag.SeriesDescription),
+		Modality:          datasetString(ds, tag.Modality),
+		InstanceNumber:    strings.TrimSpace(datasetString(ds, tag.InstanceNumber)),
+		StudyDate:         strings.TrimSpace(datasetString(ds, tag.StudyDate)),
+		PatientID:         strings.TrimSpace(datasetString(ds, tag.PatientID)),
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.removeLocked(id)
+	if inst.SOP == "" {
+		return
+	}
+	x.ids[id] = inst
+	x.sops[inst.SOP] = id
+}
+
+// remove forgets the file stored under id
+func (x *uidIndex) remove(id string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.removeLocked(id)
+}
+
+func (x *uidIndex) removeLocked(id string) {
+	inst, ok := x.ids[id]
+	if !ok {
+		return
+	}
+	delete(x.ids, id)
+	if x.sops[inst.SOP] != id {
+		return
+	}
+
+	// the same instance can be uploaded under several ids so fall
+	// back to any other copy
+	delete(x.sops, inst.SOP)
+	for _, other := range x.ids {
+		if other.SOP == inst.SOP {
+			x.sops[other.SOP] = other.ID
+			break
+		}
+	}
+}
+
+// get gives what's indexed for the file stored under id
+func (x *uidIndex) get(id string) (inst instance, ok bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	inst, ok = x.ids[id]
+	return
+}
+
+// findBySOPInstanceUID gives the id an instance is stored under
+func (x *uidIndex) findBySOPInstanceUID(uid string) (id string, ok bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	id, ok = x.sops[uid]
+	return
+}
+
+// all lists every indexed instance, ordered by id
+func (x *uidIndex) all() []instance {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	insts := slices.Collect(maps.Values(x.ids))
+	slices.SortFunc(insts, func(a, b instance) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return insts
+}
+
+type studyNode struct {
+	StudyInstanceUID string       `json:"studyInstanceUID"`
+	StudyDescription string       `json:"studyDescription"`
+	Series           []seriesNode `json:"series"`
+}
+
+type seriesNode struct {
+	SeriesInstanceUID string         `json:"seriesInstanceUID"`
+	SeriesDescription string         `json:"seriesDescription"`
+	Modality          string         `json:"modality"`
+	Instances         []instanceNode `json:"instances"`
+}
+
+type instanceNode struct {
+	ID             string `json:"id"`
+	SOPInstanceUID string `json:"sopInstanceUID"`
+	InstanceNumber string `json:"instanceNumber,omitempty"`
+}
+
+// hierarchy groups everything indexed into studies and their series,
+// descriptions come from whichever instance is seen first
+func (x *uidIndex) hierarchy() []studyNode {
+	insts := x.all()
+	slices.SortStableFunc(insts, func(a, b instance) int {
+		return cmp.Or(
+			strings.Compare(a.Study, b.Study),
+			strings.Compare(a.Series, b.Series),
+			cmp.Compare(instanceNumber(a), instanceNumber(b)),
+		)
+	})
+
+	studies := []studyNode{}
+	for _, inst := range insts {
+		if n := len(studies); n == 0 || studies[n-1].StudyInstanceUID != inst.Study {
+			studies = append(studies, studyNode{
+				StudyInstanceUID: inst.Study,
+				StudyDescription: inst.StudyDescription,
+			})
+		}
+		study := &studies[len(studies)-1]
+		if n := len(study.Series); n == 0 || study.Series[n-1].SeriesInstanceUID != inst.Series {
+			study.Series = append(study.Series, seriesNode{
+				SeriesInstanceUID: inst.Series,
+				SeriesDescription: inst.SeriesDescription,
+				Modality:          inst.Modality,
+			})
+		}
+		series := &study.Series[len(study.Series)-1]
+		series.Instances = append(series.Instances, instanceNode{inst.ID, inst.SOP, inst.InstanceNumber})
+	}
+	return studies
+}
+
+// instanceNumber orders instances the way they were acquired, ones
+// without a number go last
+func instanceNumber(inst instance) int {
+	n, err := strconv.Atoi(inst.InstanceNumber)
+	if err != nil {
+		return math.MaxInt
+	}
+	return n
+}
+
+// series lists the instances in a series in the order they were
+// acquired, an instance stored under several ids is only listed once
+func (x *uidIndex) series(study, series string) []instance {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	var insts []instance
+	for _, inst := range x.ids {
+		if inst.Study == study && inst.Series == series && x.sops[inst.SOP] == inst.ID {
+			insts = append(insts, inst)
+		}
+	}
+	slices.SortFunc(insts, func(a, b instance) int {
+		return cmp.Or(cmp.Compare(instanceNumber(a), instanceNumber(b)), strings.Compare(a.ID, b.ID))
+	})
+	return insts
+}
+
+// storeStats sums up what's indexed, instances without a modality or
+// study date count towards the total but not the breakdowns
+type storeStats struct {
+	Instances  int            `json:"instances"`
+	Patients   int            `json:"patients"`
+	Modalities map[string]int `json:"modalities"`
+	StudyDates map[string]int `json:"studyDates"`
+}
+
+// stats counts every instance once however many ids it's stored
+// under, the index already has everything it needs so nothing gets
+// parsed
+func (x *uidIndex) stats() storeStats {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	s := storeStats{Modalities: map[string]int{}, StudyDates: map[string]int{}}
+	patients := map[string]bool{}
+	for _, inst := range x.ids {
+		if x.sops[inst.SOP] != inst.ID {
+			continue
+		}
+		s.Instances++
+		if inst.Modality != "" {
+			s.Modalities[inst.Modality]++
+		}
+		if inst.StudyDate != "" {
+			s.StudyDates[inst.StudyDate]++
+		}
+		if inst.PatientID != "" {
+			patients[inst.PatientID] = true
+		}
+	}
+	s.Patients = len(patients)
+	return s
+}
+
+// lookup finds an instance by its full set of uids
+func (x *uidIndex) lookup(study, series, sop string) (instance, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	inst, ok := x.ids[x.sops[sop]]
+	return inst, ok && inst.Study == study && inst.Series == series
+}
+
+// scan indexes every file in storage, files that won't parse are
+// logged and skipped
+func (x *uidIndex) scan(ctx context.Context, storage fileStorage) (indexed, failed int, err error) {
+	entries, err := fs.ReadDir(storage.FS(ctx), ".")
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		if strings.HasPrefix(entry.Name(), ".") || !entry.Type().IsRegular() {
+			continue
+		}
+
+		ds, err := parseFile(ctx, storage, entry.Name(), dicom.SkipPixelData())
+		if err != nil {
+			log.Printf("indexing %s: %v", entry.Name(), err)
+			failed++
+			continue
+		}
+		x.add(entry.Name(), ds)
+		indexed++
+	}
+	return
+}
+
+// rebuild scans storage into a fresh index and swaps it in whole, so
+// lookups carry on from the old one meanwhile, a file written while
+// the scan is running can be missed if the scan had already passed it
+func (x *uidIndex) rebuild(ctx context.Context, storage fileStorage) (indexed, failed int, err error) {
+	fresh := newUIDIndex()
+	indexed, failed, err = fresh.scan(ctx, storage)
+	if err != nil {
+		return
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.ids, x.sops = fresh.ids, fresh.sops
+	return
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// context key the request id is kept under
+const requestIDKey = "requestId"
+
+// newUUID makes a random version 4 uuid
+func newUUID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// RequestLogger tags each request with an id, echoing the client's
+// own X-Request-ID when it sends a sane one, and logs a json line
+// for it once it's been handled
+func RequestLogger() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.GetHeader("X-Request-ID")
+		if id == "" || len(id) > 128 {
+			id = newUUID()
+		}
+		ctx.Set(requestIDKey, id)
+		ctx.Header("X-Request-ID", id)
+
+		start := time.Now()
+		ctx.Next()
+
+		attrs := []slog.Attr{
+			slog.String("requestId", id),
+			slog.String("method", ctx.Request.Method),
+			slog.String("path", ctx.Request.URL.Path),
+			slog.Int("status", ctx.Writer.Status()),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("clientIp", ctx.ClientIP()),
+		}
+		if id := ctx.Param("id"); id != "" {
+			attrs = append(attrs, slog.String("id", id))
+		}
+
+		level := slog.LevelInfo
+		if len(ctx.Errors) > 0 {
+			attrs = append(attrs, slog.Any("errors", ctx.Errors.Errors()))
+			level = slog.LevelWarn
+		}
+		if ctx.Writer.Status() >= 500 {
+			level = slog.LevelError
+		}
+		slog.LogAttrs(ctx, level, "request", attrs...)
+	}
+}
@@ -1,18 +1,35 @@
 package main
 
 import (
-	"bytes"
-	"image/png"
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"io/fs"
 	"log"
+	"log/slog"
+	"mime"
 	"net/http"
 	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/suyashkumar/dicom"
 	"github.com/suyashkumar/dicom/pkg/frame"
 	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 )
 
 // quick function to make some boilerplate easier
@@ -25,92 +42,1534 @@ func ginfn(fn func(*gin.Context) error) func(*gin.Context) {
 	}
 }
 
-func run() (err error) {
-	tmpdir, err := os.MkdirTemp(os.TempDir(), "dicomserving")
+// queryInt parses an optional non-negative integer query param,
+// falling back to def when it isn't given
+func queryInt(ctx *gin.Context, key string, def int) (int, error) {
+	s, ok := ctx.GetQuery(key)
+	if !ok {
+		return def, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err == nil && n < 0 {
+		err = errors.New("must not be negative")
+	}
+	if err != nil {
+		return 0, NewStatusError(http.StatusBadRequest, fmt.Errorf("invalid %s: %w", key, err))
+	}
+	return n, nil
+}
+
+// queryFloat parses an optional float query param, reporting whether
+// it was given at all
+func queryFloat(ctx *gin.Context, key string) (float64, bool, error) {
+	s, ok := ctx.GetQuery(key)
+	if !ok {
+		return 0, false, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false, NewStatusError(http.StatusBadRequest, fmt.Errorf("invalid %s: %w", key, err))
+	}
+	return f, true, nil
+}
+
+// limits on POST /tags, the files are parsed a few at a time so a big
+// batch can't take over every core
+const (
+	maxBatchIDs  = 1000
+	batchWorkers = 8
+)
+
+// run serves whatever is in storage until the server shuts down
+func run(storage fileStorage) (err error) {
+	router, stop, err := newRouter(storage)
 	if err != nil {
 		return
-	}
-	storage, err := os.OpenRoot(tmpdir)
-	if err != nil {
-		return err
-	}
-	defer storage.Close()
+	}
+	defer stop()
+	registerStoredFiles(storage)
+
+	// metrics sit in front of gin so scrapes don't get counted,
+	// logged or rate limited like regular requests, they still need
+	// the token though
+	var metrics http.Handler = promhttp.Handler()
+	if *authToken != "" {
+		metrics = RequireBearer(*authToken, metrics)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+	mux.Handle("/", router)
+	srv := &http.Server{Addr: *listenAddr, Handler: mux, Protocols: protocols(), IdleTimeout: *idleTimeout}
+	srv.SetKeepAlivesEnabled(*keepAlives)
+	var challenges *http.Server
+	srv.TLSConfig, challenges, err = tlsConfig()
+	if err != nil {
+		return
+	}
+	srvs := []*http.Server{srv}
+	if challenges != nil {
+		srvs = append(srvs, challenges)
+	}
+	return serve(*shutdownGrace, srvs...)
+}
+
+// newRouter sets up every route over storage, along with what runs
+// in the background for them, stop is for once nothing's being served
+// anymore
+func newRouter(storage fileStorage) (handler http.Handler, stop func(), err error) {
+	parsers = newParsePool(*parseWorkers, *parseQueue)
+	presets, err = loadWindowPresets(*windowPresets)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid WINDOW_PRESETS: %w", err)
+	}
+	locks := newIDLocks()
+	storage = trackReads(storage, locks)
+	etags := newETagCache()
+	datasets := newDatasetCache(*cacheSize)
+	frames := newFrameCache(*frameCacheSize)
+	usage := &usageCache{}
+	hooks := newWebhook(*webhookURL)
+	anonKey := anonymizationKey()
+	// changed throws away everything worked out from the file under
+	// id, for whenever it's written or removed
+	changed := func(id string) {
+		etags.remove(id)
+		datasets.remove(id)
+		frames.remove(id)
+		usage.invalidate()
+	}
+	// changedAll is changed for every file at once
+	changedAll := func() {
+		etags.clear()
+		datasets.clear()
+		frames.clear()
+		usage.invalidate()
+	}
+	index := newUIDIndex()
+	indexed, failed, err := index.scan(context.Background(), storage)
+	if err != nil {
+		return
+	}
+	log.Printf("indexed %d stored files, %d failed", indexed, failed)
+
+	switch *ginMode {
+	case gin.DebugMode, gin.ReleaseMode, gin.TestMode:
+		gin.SetMode(*ginMode)
+	default:
+		return nil, nil, fmt.Errorf("invalid GIN_MODE %q, want debug, release or test", *ginMode)
+	}
+	r := gin.New()
+	// handlers pass the gin context on as a context.Context, without
+	// this it never reports the request being cancelled
+	r.ContextWithFallback = true
+	r.Use(RequestLogger(), Metrics(), gin.Recovery(), Gzip(), ErrorHandler())
+	if *maxConcurrent > 0 {
+		r.Use(ConcurrencyLimit(*maxConcurrent, "/healthz", "/readyz"))
+	}
+	if *requestTimeout > 0 {
+		r.Use(Timeout(*requestTimeout))
+	}
+	if *allowedOrigins != "" {
+		r.Use(CORS(strings.Split(*allowedOrigins, ",")))
+	}
+	if *rateLimit > 0 {
+		r.Use(RateLimit(rate.Limit(*rateLimit), *rateBurst))
+	}
+	if *shareKey != "" {
+		r.Use(SignedURLs([]byte(*shareKey)))
+	}
+	if *authToken != "" {
+		r.Use(BearerAuth(*authToken, "/healthz", "/readyz"))
+	}
+	// ids are checked once a request is past cors, rate limiting and
+	// auth, so one with a bad id still gets its preflight answered,
+	// counts against the limit and is turned away without a token
+	r.Use(ValidateID())
+	// preserve ip address under istio/trusted proxies
+	err = r.SetTrustedProxies(strings.Split(*trustedProxies, ","))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid TRUSTED_PROXIES: %w", err)
+	}
+
+	// probes only care about the status so these skip the json
+	// error formatting and answer in plain text
+	r.GET("/healthz", func(ctx *gin.Context) {
+		ctx.String(http.StatusOK, "ok")
+	})
+
+	r.GET("/readyz", func(ctx *gin.Context) {
+		err := checkWritable(ctx, storage)
+		if err != nil {
+			ctx.String(http.StatusServiceUnavailable, "storage not writable")
+			ctx.Error(err)
+			return
+		}
+		ctx.String(http.StatusOK, "ok")
+	})
+
+	r.GET("/", ginfn(func(ctx *gin.Context) (err error) {
+		offset, err := queryInt(ctx, "offset", 0)
+		if err != nil {
+			return
+		}
+		limit, err := queryInt(ctx, "limit", -1)
+		if err != nil {
+			return
+		}
+
+		entries, err := fs.ReadDir(storage.FS(ctx), ".")
+		if err != nil {
+			return
+		}
+		// dotfiles are our own scratch files, not uploads
+		entries = slices.DeleteFunc(entries, func(entry fs.DirEntry) bool {
+			return strings.HasPrefix(entry.Name(), ".")
+		})
+		entries = entries[min(offset, len(entries)):]
+		if limit >= 0 {
+			entries = entries[:min(limit, len(entries))]
+		}
+
+		type file struct {
+			ID   string `json:"id"`
+			Size int64  `json:"size"`
+		}
+		files := []file{}
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			files = append(files, file{entry.Name(), info.Size()})
+		}
+
+		ctx.JSON(http.StatusOK, files)
+		return
+	}))
+
+	// ServeContent leaves the body out of HEAD responses itself
+	r.Match([]string{http.MethodGet, http.MethodHead}, "/:id", ginfn(func(ctx *gin.Context) (err error) {
+		id := ctx.Param("id")
+		file, err := openFile(ctx, storage, id)
+		if err != nil {
+			return
+		}
+		defer file.Close()
+		// ServeContent takes care of If-None-Match and 304s once it
+		// sees the etag
+		getETag := etags.get
+		if ctx.Request.Method == http.MethodHead {
+			getETag = etags.quick
+		}
+		etag, err := getETag(id, file)
+		if err != nil {
+			return
+		}
+		ctx.Header("ETag", etag)
+		isDICOM := hasDICOMMagic(file)
+
+		// files stored without validation might be anything, those
+		// are left to ServeContent's sniffing
+		disposition := "inline"
+		if ctx.Query("download") == "true" {
+			disposition = "attachment"
+		}
+		name := id
+		if isDICOM {
+			ctx.Header("Content-Type", "application/dicom")
+			if !strings.HasSuffix(strings.ToLower(name), ".dcm") {
+				name += ".dcm"
+			}
+		}
+		ctx.Header("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": name}))
+
+		// ServeContent answers Range requests itself, the header is set
+		// up front so it's advertised on every response
+		ctx.Header("Accept-Ranges", "bytes")
+		return serveStored(ctx, id, file)
+	}))
+
+	r.PUT("/:id", ginfn(func(ctx *gin.Context) (err error) {
+		id := ctx.Param("id")
+		unlock := locks.lock(id)
+		defer unlock()
+		err = checkIfMatch(ctx, storage, etags, id)
+		if err != nil {
+			return
+		}
+		err = checkOverwrite(ctx, storage, id)
+		if err != nil {
+			return
+		}
+
+		// the upload's staged so readers never see it half written
+		// and a failed upload leaves the old file be, it's thrown away
+		// when it's cut short by the client hanging up, the timeout or
+		// the size limit, files being validated are parsed on their
+		// way in
+		body := &countingReader{Reader: http.MaxBytesReader(ctx.Writer, ctx.Request.Body, *maxUpload)}
+		sum := sha256.New()
+		upload := contextReader{ctx, io.TeeReader(body, sum)}
+		skip := ctx.Query("skipValidation") == "true"
+		file, err := stage(ctx, storage, id)
+		if err != nil {
+			return
+		}
+		defer file.Abort()
+		var ds dicom.Dataset
+		if skip {
+			err = writeUpload(file, upload)
+		} else {
+			ds, err = writeValidated(ctx, file, upload)
+		}
+		if body.n == 0 && !notTheUpload(err) {
+			return NewStatusError(http.StatusBadRequest, errors.New("empty upload"))
+		}
+		var tooBig *http.MaxBytesError
+		if errors.As(err, &tooBig) {
+			err = NewStatusError(http.StatusRequestEntityTooLarge, err)
+		}
+		if err != nil {
+			return
+		}
+		err = file.Commit()
+		if err != nil {
+			return
+		}
+		changed(id)
+		ctx.Header("ETag", primeETag(ctx, storage, etags, id, sum))
+
+		// unvalidated files aren't parsed so they can't be indexed
+		// either
+		if skip {
+			index.remove(id)
+			recordUpload(dicom.Dataset{}, body.n)
+			hooks.notify("stored", id, "")
+			ctx.JSON(http.StatusOK, gin.H{"id": id})
+			return
+		}
+		index.add(id, ds)
+		recordUpload(ds, body.n)
+		hooks.notify("stored", id, datasetString(ds, tag.SOPInstanceUID))
+		ctx.JSON(http.StatusOK, gin.H{"id": id, "sopInstanceUID": datasetString(ds, tag.SOPInstanceUID)})
+		return
+	}))
+
+	// POST is for when the client has no id of its own in mind, a
+	// fresh uuid can't collide with anything already stored
+	r.POST("/", ginfn(func(ctx *gin.Context) (err error) {
+		id := newUUID()
+		body := &countingReader{Reader: http.MaxBytesReader(ctx.Writer, ctx.Request.Body, *maxUpload)}
+		ds, unlock, err := storeInstance(ctx, storage, locks, contextReader{ctx, body}, id)
+		var tooBig *http.MaxBytesError
+		if errors.As(err, &tooBig) {
+			err = NewStatusError(http.StatusRequestEntityTooLarge, err)
+		}
+		if err != nil {
+			return
+		}
+		defer unlock()
+		changed(id)
+		index.add(id, ds)
+		recordUpload(ds, body.n)
+		hooks.notify("stored", id, datasetString(ds, tag.SOPInstanceUID))
+
+		ctx.Header("Location", baseURL(ctx)+"/"+id)
+		ctx.JSON(http.StatusCreated, gin.H{"id": id, "sopInstanceUID": datasetString(ds, tag.SOPInstanceUID)})
+		return
+	}))
+
+	r.POST("/studies", ginfn(func(ctx *gin.Context) (err error) {
+		parts, err := dicomParts(ctx.Request)
+		if err != nil {
+			return
+		}
+
+		var stored []dicom.Dataset
+		var failed []error
+		for {
+			part, err := parts.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return NewStatusError(http.StatusBadRequest, err)
+			}
+
+			body := &countingReader{Reader: http.MaxBytesReader(ctx.Writer, part, *maxUpload)}
+			ds, unlock, err := storeInstance(ctx, storage, locks, contextReader{ctx, body}, "")
+			if err != nil {
+				ctx.Error(err)
+				failed = append(failed, err)
+				continue
+			}
+			sop := datasetString(ds, tag.SOPInstanceUID)
+			changed(sop)
+			index.add(sop, ds)
+			unlock()
+			recordUpload(ds, body.n)
+			hooks.notify("stored", sop, sop)
+			stored = append(stored, ds)
+		}
+		if len(stored)+len(failed) == 0 {
+			return NewStatusError(http.StatusBadRequest, errors.New("no instances in request"))
+		}
+
+		code, resp := stowResponse(ctx, stored, failed)
+		ctx.Header("Content-Type", "application/dicom+json")
+		ctx.JSON(code, resp)
+		return
+	}))
+
+	r.POST("/bulk", ginfn(func(ctx *gin.Context) (err error) {
+		// zip needs random access to find its directory, so the
+		// archive goes to disk rather than being held in memory
+		name, err := writeScratch(ctx, storage, contextReader{ctx, http.MaxBytesReader(ctx.Writer, ctx.Request.Body, *maxUpload)})
+		var tooBig *http.MaxBytesError
+		if errors.As(err, &tooBig) {
+			err = NewStatusError(http.StatusRequestEntityTooLarge, err)
+		}
+		if err != nil {
+			return
+		}
+		defer storage.Remove(context.WithoutCancel(ctx), name)
+
+		file, err := storage.Open(ctx, name)
+		if err != nil {
+			return
+		}
+		defer file.Close()
+		info, err := file.Stat()
+		if err != nil {
+			return
+		}
+		zr, err := zip.NewReader(file, info.Size())
+		if err != nil {
+			return NewStatusError(http.StatusBadRequest, fmt.Errorf("invalid zip: %w", err))
+		}
+
+		type entry struct {
+			Name  string `json:"name"`
+			ID    string `json:"id,omitempty"`
+			Error string `json:"error,omitempty"`
+		}
+		stored, failed := []entry{}, []entry{}
+		byName := ctx.Query("key") == "filename"
+		for _, f := range zr.File {
+			if f.FileInfo().IsDir() {
+				continue
+			}
+			var id string
+			if byName {
+				id = path.Base(f.Name)
+			}
+			ds, unlock, err := storeZipEntry(ctx, storage, locks, f, id)
+			if err != nil {
+				ctx.Error(err)
+				failed = append(failed, entry{Name: f.Name, Error: err.Error()})
+				continue
+			}
+			if id == "" {
+				id = datasetString(ds, tag.SOPInstanceUID)
+			}
+			changed(id)
+			index.add(id, ds)
+			unlock()
+			recordUpload(ds, int64(f.UncompressedSize64))
+			hooks.notify("stored", id, datasetString(ds, tag.SOPInstanceUID))
+			stored = append(stored, entry{Name: f.Name, ID: id})
+		}
+		if len(stored)+len(failed) == 0 {
+			return NewStatusError(http.StatusBadRequest, errors.New("no files in archive"))
+		}
+
+		code := http.StatusOK
+		switch {
+		case len(stored) == 0:
+			code = http.StatusConflict
+		case len(failed) > 0:
+			code = http.StatusAccepted
+		}
+		ctx.JSON(code, gin.H{"stored": stored, "failed": failed})
+		return
+	}))
+
+	r.GET("/studies", ginfn(func(ctx *gin.Context) (err error) {
+		q, err := parseStudyQuery(ctx)
+		if err != nil {
+			return
+		}
+		offset, err := queryInt(ctx, "offset", 0)
+		if err != nil {
+			return
+		}
+		limit, err := queryInt(ctx, "limit", -1)
+		if err != nil {
+			return
+		}
+
+		// study level attributes are shared by every instance in a
+		// study so any one of them can stand in for it
+		var studies []instance
+		counts := map[string]int{}
+		for _, inst := range index.all() {
+			if inst.Study == "" {
+				continue
+			}
+			if counts[inst.Study] == 0 {
+				studies = append(studies, inst)
+			}
+			counts[inst.Study]++
+		}
+		slices.SortFunc(studies, func(a, b instance) int {
+			return strings.Compare(a.Study, b.Study)
+		})
+
+		results := []jsonDataset{}
+		for _, inst := range studies {
+			if limit >= 0 && len(results) == limit {
+				break
+			}
+
+			ds, err := parseFile(ctx, storage, inst.ID, dicom.SkipPixelData())
+			if err != nil {
+				ctx.Error(err)
+				continue
+			}
+			if !q.matches(ds) {
+				continue
+			}
+			if offset > 0 {
+				offset--
+				continue
+			}
+
+			result := q.result(ds)
+			result.set(tag.NumberOfStudyRelatedInstances, "IS", counts[inst.Study])
+			results = append(results, result)
+		}
+
+		ctx.Header("Content-Type", "application/dicom+json")
+		ctx.JSON(http.StatusOK, results)
+		return
+	}))
+
+	r.GET("/usage", ginfn(func(ctx *gin.Context) (err error) {
+		u, err := usage.get(ctx, storage)
+		if err != nil {
+			return
+		}
+		if ctx.Query("byStudy") != "true" {
+			ctx.JSON(http.StatusOK, u)
+			return
+		}
+
+		// files that were never indexed don't belong to any study we
+		// know of and are left out of the breakdown
+		type studyUsage struct {
+			Bytes int64 `json:"bytes"`
+			Files int   `json:"files"`
+		}
+		studies := map[string]*studyUsage{}
+		for _, inst := range index.all() {
+			size, ok := u.sizes[inst.ID]
+			if !ok || inst.Study == "" {
+				continue
+			}
+			if studies[inst.Study] == nil {
+				studies[inst.Study] = &studyUsage{}
+			}
+			studies[inst.Study].Bytes += size
+			studies[inst.Study].Files++
+		}
+		ctx.JSON(http.StatusOK, gin.H{"bytes": u.Bytes, "files": u.Files, "studies": studies})
+		return
+	}))
+
+	r.GET("/hierarchy", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, index.hierarchy())
+	})
+
+	r.GET("/stats", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, index.stats())
+	})
+
+	// for when files have been moved around on the volume behind our
+	// back and the index no longer matches what's there, nothing
+	// worked out from the old files can be trusted either
+	r.POST("/admin/reindex", ginfn(func(ctx *gin.Context) (err error) {
+		if *authToken == "" {
+			return NewStatusError(http.StatusForbidden, errors.New("reindexing needs AUTH_TOKEN set"))
+		}
+		indexed, failed, err := index.rebuild(ctx, storage)
+		if err != nil {
+			return
+		}
+		changedAll()
+		slog.Info("rebuilt uid index", "indexed", indexed, "failed", failed)
+		ctx.JSON(http.StatusOK, gin.H{"indexed": indexed, "failed": failed})
+		return
+	}))
+
+	r.GET("/studies/:study/series/:series/instances/:sop", ginfn(func(ctx *gin.Context) (err error) {
+		inst, ok := index.lookup(ctx.Param("study"), ctx.Param("series"), ctx.Param("sop"))
+		if !ok {
+			return NewStatusError(http.StatusNotFound, errors.New("no such instance"))
+		}
+
+		file, err := openFile(ctx, storage, inst.ID)
+		if err != nil {
+			return
+		}
+		defer file.Close()
+
+		ctx.Header("Content-Type", "application/dicom")
+		ctx.Header("Accept-Ranges", "bytes")
+		return serveStored(ctx, inst.ID, file)
+	}))
+
+	r.GET("/studies/:study/series/:series/metadata", ginfn(func(ctx *gin.Context) (err error) {
+		insts := index.series(ctx.Param("study"), ctx.Param("series"))
+		if len(insts) == 0 {
+			return NewStatusError(http.StatusNotFound, errors.New("no such series"))
+		}
+
+		// instances get loaded a few ahead of the one being written
+		// so the array starts going out straight away without every
+		// dataset piling up in memory, the loads go through the
+		// parse pool like any other
+		c, cancel := context.WithCancel(ctx)
+		defer cancel()
+		type loaded struct {
+			ds  dicom.Dataset
+			err error
+		}
+		pending := make(chan chan loaded, batchWorkers)
+		go func() {
+			defer close(pending)
+			for _, inst := range insts {
+				done := make(chan loaded, 1)
+				select {
+				case <-c.Done():
+					return
+				case pending <- done:
+				}
+				go func() {
+					ds, err := datasets.load(c, storage, inst.ID)
+					done <- loaded{ds, err}
+				}()
+			}
+		}()
+
+		ctx.Header("Content-Type", "application/dicom+json")
+		ctx.Status(http.StatusOK)
+		_, err = ctx.Writer.WriteString("[")
+
+		// like the archive it's too late for a status once the array
+		// has started so a failure just cuts it short, the rest are
+		// still waited on so nothing outlives the request
+		written := 0
+		write := func(ds dicom.Dataset) error {
+			// the cache keeps a placeholder where the pixel data was
+			elems := slices.DeleteFunc(slices.Clone(ds.Elements), func(elem *dicom.Element) bool {
+				return elem.Tag == tag.PixelData
+			})
+			b, err := json.Marshal(toJSONDataset(elems))
+			if err != nil {
+				return err
+			}
+			if written > 0 {
+				b = append([]byte(","), b...)
+			}
+			written++
+			_, err = ctx.Writer.Write(b)
+			ctx.Writer.Flush()
+			return err
+		}
+		for done := range pending {
+			l := <-done
+			switch {
+			case err != nil:
+			case errors.Is(l.err, fs.ErrNotExist):
+				// deleted since it was listed
+			case l.err != nil:
+				err = l.err
+			default:
+				err = write(l.ds)
+			}
+			if err != nil {
+				cancel()
+			}
+		}
+		if err != nil {
+			return
+		}
+		_, err = ctx.Writer.WriteString("]")
+		return
+	}))
+
+	r.GET("/studies/:study/archive", ginfn(func(ctx *gin.Context) (err error) {
+		study := ctx.Param("study")
+		insts := slices.DeleteFunc(index.all(), func(inst instance) bool {
+			return inst.Study != study
+		})
+		if len(insts) == 0 {
+			return NewStatusError(http.StatusNotFound, errors.New("no such study"))
+		}
+
+		ctx.Header("Content-Type", "application/zip")
+		ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": study + ".zip"}))
+		ctx.Status(http.StatusOK)
+
+		// entries go straight out as they're compressed, once the
+		// first is written it's too late to report failure with a
+		// status so the archive just ends early
+		zw := zip.NewWriter(ctx.Writer)
+		for _, inst := range insts {
+			err = func() error {
+				file, err := openStored(ctx, storage, inst.ID)
+				if errors.Is(err, fs.ErrNotExist) {
+					// deleted since it was listed
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				name := inst.ID
+				if !strings.HasSuffix(strings.ToLower(name), ".dcm") {
+					name += ".dcm"
+				}
+				w, err := zw.Create(inst.Series + "/" + name)
+				if err != nil {
+					return err
+				}
+				_, err = io.Copy(w, file)
+				return err
+			}()
+			if err != nil {
+				return
+			}
+		}
+		return zw.Close()
+	}))
+
+	r.DELETE("/:id", ginfn(func(ctx *gin.Context) (err error) {
+		unlock := locks.lock(ctx.Param("id"))
+		defer unlock()
+
+		etags.remove(ctx.Param("id"))
+		err = storage.Remove(ctx, ctx.Param("id"))
+		removed := err == nil
+		if errors.Is(err, fs.ErrNotExist) {
+			if ctx.Query("ignoreMissing") == "true" {
+				err = nil
+			} else {
+				err = NewStatusError(http.StatusNotFound, err)
+			}
+		}
+		if err != nil {
+			return
+		}
+		inst, _ := index.get(ctx.Param("id"))
+		changed(ctx.Param("id"))
+		index.remove(ctx.Param("id"))
+		if removed {
+			hooks.notify("deleted", ctx.Param("id"), inst.SOP)
+		}
+
+		ctx.Status(http.StatusNoContent)
+		return
+	}))
+
+	// a share link gets anyone who has it read access to id and
+	// everything under it, until it expires
+	r.POST("/:id/share", ginfn(func(ctx *gin.Context) (err error) {
+		if *shareKey == "" {
+			return NewStatusError(http.StatusNotImplemented, errors.New("sharing is off, SHARE_SIGNING_KEY isn't set"))
+		}
+		ttl, err := time.ParseDuration(ctx.DefaultQuery("expiresIn", "1h"))
+		if err != nil {
+			return NewStatusError(http.StatusBadRequest, fmt.Errorf("invalid expiresIn: %w", err))
+		}
+		if ttl <= 0 {
+			return NewStatusError(http.StatusBadRequest, errors.New("expiresIn must be positive"))
+		}
+		id := ctx.Param("id")
+		_, err = storage.Stat(ctx, id)
+		if errors.Is(err, fs.ErrNotExist) {
+			err = NewStatusError(http.StatusNotFound, err)
+		}
+		if err != nil {
+			return
+		}
+		expires := time.Now().Add(ttl).Truncate(time.Second)
+		ctx.JSON(http.StatusOK, gin.H{"url": shareURL(ctx, []byte(*shareKey), id, expires), "expires": expires.UTC()})
+		return
+	}))
+
+	r.GET("/:id/tag", ginfn(func(ctx *gin.Context) (err error) {
+		// resolve every name and path up front so a typo doesn't
+		// cost a parse, each is looked up under the key it was
+		// asked for by
+		type lookup struct {
+			key  string
+			find func(dicom.Dataset) (*dicom.Element, error)
+		}
+		var lookups []lookup
+		// the cache goes without pixel data so asking for it means a
+		// full parse
+		var pixels bool
+		for _, name := range ctx.QueryArray("name") {
+			info, err := findTagName(name)
+			if err != nil {
+				return NewStatusError(http.StatusBadRequest, err)
+			}
+			pixels = pixels || info.Tag == tag.PixelData
+			lookups = append(lookups, lookup{info.Name, func(ds dicom.Dataset) (*dicom.Element, error) {
+				return ds.FindElementByTagNested(info.Tag)
+			}})
+		}
+		// raw tags skip the dictionary, for private tags or ones it
+		// doesn't know about
+		raw := ctx.QueryArray("tag")
+		if group, elem := ctx.Query("group"), ctx.Query("element"); group != "" || elem != "" {
+			raw = append(raw, group+","+elem)
+		}
+		for _, key := range raw {
+			t, err := lookupTag(key)
+			if err != nil {
+				return NewStatusError(http.StatusBadRequest, err)
+			}
+			pixels = pixels || t == tag.PixelData
+			lookups = append(lookups, lookup{key, func(ds dicom.Dataset) (*dicom.Element, error) {
+				return ds.FindElementByTagNested(t)
+			}})
+		}
+		for _, p := range ctx.QueryArray("path") {
+			tp, err := parseTagPath(p)
+			if err != nil {
+				return NewStatusError(http.StatusBadRequest, err)
+			}
+			pixels = pixels || slices.Contains(tp.tags, tag.PixelData)
+			lookups = append(lookups, lookup{p, func(ds dicom.Dataset) (*dicom.Element, error) {
+				return tp.find(ds.Elements)
+			}})
+		}
+		if len(lookups) == 0 {
+			err = NewStatusError(http.StatusBadRequest, errors.New("missing tag name, path or number"))
+			return
+		}
+		// sequences nested past depth are only counted, for files with
+		// structures too deeply nested to be worth sending whole
+		depth, err := queryInt(ctx, "depth", -1)
+		if err != nil {
+			return
+		}
+		format, err := metadataFormat(ctx)
+		if err != nil {
+			return
+		}
+
+		var dcom dicom.Dataset
+		start := time.Now()
+		if pixels {
+			dcom, err = parseFile(ctx, storage, ctx.Param("id"))
+		} else {
+			dcom, err = datasets.load(ctx, storage, ctx.Param("id"))
+		}
+		if err != nil {
+			return
+		}
+		serverTiming(ctx.Writer.Header(), "parse", "", time.Since(start))
+
+		// the dicom models have nowhere to say an element's missing so
+		// those are left out, unless it was the only one asked for
+		if format != formatJSON {
+			var elems []*dicom.Element
+			for _, l := range lookups {
+				elem, err := l.find(dcom)
+				if err != nil && len(lookups) == 1 {
+					return NewStatusError(http.StatusNotFound, fmt.Errorf("%s: %w", l.key, err))
+				}
+				if errors.Is(err, dicom.ErrorElementNotFound) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				elems = append(elems, elem)
+			}
+			return writeModel(ctx, format, elems)
+		}
+
+		// a lone name keeps returning the bare element, and a 404 if
+		// there isn't one
+		if len(lookups) == 1 {
+			elem, err := lookups[0].find(dcom)
+			if err != nil {
+				return NewStatusError(http.StatusNotFound, fmt.Errorf("%s: %w", lookups[0].key, err))
+			}
+			ctx.JSON(http.StatusOK, tagElement(elem, depth))
+			return nil
+		}
+
+		// several get whatever could be found under tags, with
+		// anything the file doesn't have as null there and listed
+		// under missing, so no lookup can clash with the list
+		found := make(gin.H, len(lookups))
+		missing := []string{}
+		for _, l := range lookups {
+			elem, err := l.find(dcom)
+			if errors.Is(err, dicom.ErrorElementNotFound) {
+				found[l.key] = nil
+				missing = append(missing, l.key)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			found[l.key] = tagElement(elem, depth)
+		}
+		ctx.JSON(http.StatusOK, gin.H{"tags": found, "missing": missing})
+		return
+	}))
+
+	r.GET("/tags/dictionary", ginfn(func(ctx *gin.Context) (err error) {
+		ctx.JSON(http.StatusOK, searchDictionary(ctx.Query("filter")))
+		return
+	}))
+
+	r.POST("/tags", ginfn(func(ctx *gin.Context) (err error) {
+		var req struct {
+			IDs  []string `json:"ids"`
+			Tags []string `json:"tags"`
+		}
+		if err = ctx.ShouldBindJSON(&req); err != nil {
+			return NewStatusError(http.StatusBadRequest, err)
+		}
+		if len(req.IDs) == 0 || len(req.Tags) == 0 {
+			return NewStatusError(http.StatusBadRequest, errors.New("ids and tags are both needed"))
+		}
+		if len(req.IDs) > maxBatchIDs {
+			return NewStatusError(http.StatusBadRequest, fmt.Errorf("at most %d ids at once", maxBatchIDs))
+		}
+		tags := make([]tag.Tag, len(req.Tags))
+		for i, name := range req.Tags {
+			tags[i], err = lookupTag(name)
+			if err != nil {
+				return NewStatusError(http.StatusBadRequest, err)
+			}
+			// batches only ever go through the cache, which doesn't
+			// have it
+			if tags[i] == tag.PixelData {
+				return NewStatusError(http.StatusBadRequest, errors.New("pixel data can't be extracted in a batch"))
+			}
+		}
+
+		// one file failing only costs that file its entry, so the
+		// workers never return an error
+		results := make([]gin.H, len(req.IDs))
+		var grp errgroup.Group
+		grp.SetLimit(batchWorkers)
+		for i, id := range req.IDs {
+			grp.Go(func() error {
+				err := validID(id)
+				var dcom dicom.Dataset
+				if err == nil {
+					dcom, err = datasets.load(ctx, storage, id)
+				}
+				if err != nil {
+					results[i] = gin.H{"error": err.Error()}
+					return nil
+				}
+				vals := make(gin.H, len(tags))
+				for j, t := range tags {
+					vals[req.Tags[j]] = nil
+					if elem, err := dcom.FindElementByTagNested(t); err == nil {
+						vals[req.Tags[j]] = tagValue(elem)
+					}
+				}
+				results[i] = vals
+				return nil
+			})
+		}
+		grp.Wait()
+
+		resp := make(gin.H, len(results))
+		for i, id := range req.IDs {
+			resp[id] = results[i]
+		}
+		ctx.JSON(http.StatusOK, resp)
+		return
+	}))
+
+	r.GET("/diff", ginfn(func(ctx *gin.Context) (err error) {
+		a, b := ctx.Query("a"), ctx.Query("b")
+		if a == "" || b == "" {
+			return NewStatusError(http.StatusBadRequest, errors.New("a and b are both needed"))
+		}
+		for _, id := range []string{a, b} {
+			if err = validID(id); err != nil {
+				return NewStatusError(http.StatusBadRequest, err)
+			}
+		}
+		var names []tag.Tag
+		for _, name := range ctx.QueryArray("name") {
+			info, err := findTagName(name)
+			if err != nil {
+				return NewStatusError(http.StatusBadRequest, err)
+			}
+			if info.Tag == tag.PixelData {
+				return NewStatusError(http.StatusBadRequest, errors.New("pixel data can't be compared"))
+			}
+			names = append(names, info.Tag)
+		}
+
+		da, err := datasets.load(ctx, storage, a)
+		if err != nil {
+			return
+		}
+		db, err := datasets.load(ctx, storage, b)
+		if err != nil {
+			return
+		}
+		diff, err := diffDatasets(da, db, names)
+		if err != nil {
+			return
+		}
+		ctx.JSON(http.StatusOK, diff)
+		return
+	}))
+
+	r.PATCH("/:id/tag", ginfn(func(ctx *gin.Context) (err error) {
+		t, err := lookupTag(ctx.Query("name"))
+		if err != nil {
+			return NewStatusError(http.StatusBadRequest, err)
+		}
+		// the meta header and pixel data describe the encoding of the
+		// file itself, changing those would just corrupt it
+		if t.Group == tag.MetadataGroup || t == tag.PixelData {
+			return NewStatusError(http.StatusBadRequest, fmt.Errorf("%s can't be modified", t))
+		}
+		var body struct {
+			Value json.RawMessage `json:"value"`
+		}
+		err = ctx.ShouldBindJSON(&body)
+		if err == nil && body.Value == nil {
+			err = errors.New("missing value")
+		}
+		if err != nil {
+			return NewStatusError(http.StatusBadRequest, err)
+		}
+		vals, err := jsonValues(body.Value)
+		if err != nil {
+			return NewStatusError(http.StatusBadRequest, err)
+		}
+
+		id := ctx.Param("id")
+		unlock := locks.lock(id)
+		defer unlock()
+		err = checkIfMatch(ctx, storage, etags, id)
+		if err != nil {
+			return
+		}
+
+		dcom, err := parseFile(ctx, storage, id)
+		if err != nil {
+			return
+		}
+		elem, err := dcom.FindElementByTag(t)
+		if err != nil {
+			return NewStatusError(http.StatusNotFound, fmt.Errorf("%s: %w", t, err))
+		}
+		data, err := vrValue(elem.RawValueRepresentation, vals)
+		if err != nil {
+			return NewStatusError(http.StatusBadRequest, err)
+		}
+		elem.Value, err = dicom.NewValue(data)
+		if err != nil {
+			return
+		}
+
+		err = replaceFile(ctx, storage, id, func(w io.Writer) error {
+			return writeDataset(w, dcom)
+		})
+		if err != nil {
+			return
+		}
+		changed(id)
+		index.add(id, dcom)
+
+		// read it back for the lengths the writer worked out
+		dcom, err = parseFile(ctx, storage, id, dicom.SkipPixelData())
+		if err != nil {
+			return
+		}
+		elem, err = dcom.FindElementByTag(t)
+		if err != nil {
+			return
+		}
+		etag, err := storedETag(ctx, storage, etags, id)
+		if err != nil {
+			return
+		}
+		ctx.Header("ETag", etag)
+		ctx.JSON(http.StatusOK, tagElement(elem, -1))
+		return
+	}))
 
-	r := gin.New()
-	r.Use(gin.Logger(), gin.Recovery(), gin.ErrorLogger())
-	// preserve ip address under istio/trusted proxies
-	r.SetTrustedProxies([]string{"127.0.0.0/8", "::1"})
+	r.POST("/:id/transcode", ginfn(func(ctx *gin.Context) (err error) {
+		id := ctx.Param("id")
+		unlock := locks.lock(id)
+		defer unlock()
+		err = checkIfMatch(ctx, storage, etags, id)
+		if err != nil {
+			return
+		}
 
-	r.GET("/:id", func(ctx *gin.Context) {
-		ctx.FileFromFS(ctx.Param("id"), http.FS(storage.FS()))
-	})
+		dcom, err := parseFile(ctx, storage, id)
+		if err != nil {
+			return
+		}
 
-	r.PUT("/:id", ginfn(func(ctx *gin.Context) (err error) {
-		file, err := storage.Create(ctx.Param("id"))
+		err = transcode(&dcom)
 		if err != nil {
 			return
 		}
-		defer file.Close()
+		err = replaceFile(ctx, storage, id, func(w io.Writer) error {
+			return writeDataset(w, dcom)
+		})
+		if err != nil {
+			return
+		}
+		changed(id)
 
-		_, err = io.Copy(file, ctx.Request.Body)
+		etag, err := storedETag(ctx, storage, etags, id)
+		if err != nil {
+			return
+		}
+		ctx.Header("ETag", etag)
+		ctx.JSON(http.StatusOK, gin.H{"id": id, "transferSyntaxUID": uid.ExplicitVRLittleEndian})
 		return
 	}))
 
-	r.GET("/:id/tag", ginfn(func(ctx *gin.Context) (err error) {
-		tag, err := tag.FindByName(ctx.Query("name"))
+	r.POST("/:id/anonymize", ginfn(func(ctx *gin.Context) (err error) {
+		id := ctx.Param("id")
+		unlock := locks.lock(id)
+		defer unlock()
+
+		dcom, err := parseFile(ctx, storage, id)
+		if err != nil {
+			return
+		}
+		err = anonymize(&dcom, anonKey)
 		if err != nil {
-			ctx.Status(http.StatusBadRequest)
 			return
 		}
 
-		file, err := storage.Open(ctx.Param("id"))
+		// copies go under their new SOPInstanceUID, like STOW-RS
+		// uploads do
+		newID := id
+		if ctx.Query("inPlace") != "true" {
+			newID = datasetString(dcom, tag.SOPInstanceUID)
+		}
+		if newID == "" {
+			newID = newUUID()
+		}
+		if newID != id {
+			unlockNew := locks.lock(newID)
+			defer unlockNew()
+		}
+
+		err = replaceFile(ctx, storage, newID, func(w io.Writer) error {
+			return writeDataset(w, dcom)
+		})
+		if err != nil {
+			return
+		}
+		changed(newID)
+		index.add(newID, dcom)
+
+		code := http.StatusOK
+		if newID != id {
+			code = http.StatusCreated
+			ctx.Header("Location", baseURL(ctx)+"/"+newID)
+		}
+		ctx.JSON(code, gin.H{"id": newID})
+		return
+	}))
+
+	// validation only reports, nothing gets stored or changed
+	r.POST("/validate", ginfn(func(ctx *gin.Context) (err error) {
+		ds, err := validateDICOM(ctx, http.MaxBytesReader(ctx.Writer, ctx.Request.Body, *maxUpload))
+		var tooBig *http.MaxBytesError
+		if errors.As(err, &tooBig) {
+			return NewStatusError(http.StatusRequestEntityTooLarge, err)
+		}
+		if notTheUpload(err) {
+			return
+		}
+		ctx.JSON(http.StatusOK, validationReport(ds, err))
+		return nil
+	}))
+
+	r.GET("/:id/validate", ginfn(func(ctx *gin.Context) (err error) {
+		ds, err := datasets.load(ctx, storage, ctx.Param("id"))
+		var serr *StatusError
+		if errors.As(err, &serr) {
+			return
+		}
+		ctx.JSON(http.StatusOK, validationReport(ds, err))
+		return nil
+	}))
+
+	r.GET("/:id/parse-check", ginfn(func(ctx *gin.Context) (err error) {
+		file, err := openFile(ctx, storage, ctx.Param("id"))
 		if err != nil {
 			return
 		}
 		defer file.Close()
 
-		dcom, err := dicom.ParseUntilEOF(file, nil)
+		report, err := parseCheck(ctx, file)
 		if err != nil {
 			return
 		}
+		ctx.JSON(http.StatusOK, report)
+		return
+	}))
 
-		elem, err := dcom.FindElementByTagNested(tag.Tag)
+	r.GET("/:id/info", ginfn(func(ctx *gin.Context) (err error) {
+		meta, err := parseMeta(ctx, storage, ctx.Param("id"))
 		if err != nil {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, elem)
+		ts := datasetString(meta, tag.TransferSyntaxUID)
+		class := datasetString(meta, tag.MediaStorageSOPClassUID)
+		info := gin.H{
+			"transferSyntaxUID":       ts,
+			"transferSyntax":          uidName(ts),
+			"mediaStorageSOPClassUID": class,
+			"mediaStorageSOPClass":    uidName(class),
+		}
+		// unknown syntaxes leave the encoding details out rather than
+		// guess at them
+		if bo, implicit, err := uid.ParseTransferSyntaxUID(ts); err == nil {
+			info["explicitVR"] = !implicit
+			info["littleEndian"] = bo == binary.LittleEndian
+			info["encapsulated"] = ts != uid.ImplicitVRLittleEndian && ts != uid.ExplicitVRLittleEndian && ts != uid.ExplicitVRBigEndian
+		}
+		ctx.JSON(http.StatusOK, info)
 		return
 	}))
 
-	r.GET("/:id/image", ginfn(func(ctx *gin.Context) (err error) {
-		file, err := storage.Open(ctx.Param("id"))
+	// the meta group is all that's read so this costs the same
+	// however big the file is
+	r.GET("/:id/meta", ginfn(func(ctx *gin.Context) (err error) {
+		meta, err := parseMeta(ctx, storage, ctx.Param("id"))
+		if err != nil {
+			return
+		}
+		ctx.JSON(http.StatusOK, meta)
+		return
+	}))
+
+	r.GET("/:id/metadata", ginfn(func(ctx *gin.Context) (err error) {
+		format, err := metadataFormat(ctx)
+		if err != nil {
+			return
+		}
+		includePixels := ctx.Query("includePixelData") == "true"
+		// the dicom models only have pixel data as bulk data by
+		// reference, which there's nothing to point at for
+		if format != formatJSON && includePixels {
+			return NewStatusError(http.StatusBadRequest, fmt.Errorf("pixel data can't be included as %s", format))
+		}
+
+		// pixel data dwarfs everything else so leave it out unless
+		// it's explicitly asked for
+		var dcom dicom.Dataset
+		start := time.Now()
+		if includePixels {
+			dcom, err = parseFile(ctx, storage, ctx.Param("id"))
+		} else {
+			dcom, err = datasets.load(ctx, storage, ctx.Param("id"))
+			// cached datasets are shared so this works on a copy
+			dcom.Elements = slices.DeleteFunc(slices.Clone(dcom.Elements), func(elem *dicom.Element) bool {
+				return elem.Tag == tag.PixelData
+			})
+		}
+		if err != nil {
+			return
+		}
+		serverTiming(ctx.Writer.Header(), "parse", "", time.Since(start))
+
+		if format != formatJSON {
+			return writeModel(ctx, format, dcom.Elements)
+		}
+		ctx.JSON(http.StatusOK, dcom)
+		return
+	}))
+
+	// encapsulated pdf and cda reports are served as the documents
+	// they are, rather than as dicom
+	r.GET("/:id/document", ginfn(func(ctx *gin.Context) (err error) {
+		dcom, err := datasets.load(ctx, storage, ctx.Param("id"))
+		if err != nil {
+			return
+		}
+		doc, ok := datasetDocument(dcom)
+		if !ok {
+			return NewStatusError(http.StatusNotFound, errors.New("no encapsulated document"))
+		}
+		ctx.Header("X-Content-Type-Options", "nosniff")
+		ctx.Header("Content-Disposition", doc.disposition(ctx.Param("id")))
+		ctx.Data(http.StatusOK, doc.mimeType, doc.data)
+		return
+	}))
+
+	r.GET("/:id/frames", ginfn(func(ctx *gin.Context) (err error) {
+		file, err := openFile(ctx, storage, ctx.Param("id"))
 		if err != nil {
 			return
 		}
 		defer file.Close()
+		release, err := parsers.acquire(ctx)
+		if err != nil {
+			return
+		}
+		defer release()
+
+		type frameInfo struct {
+			Rows         int  `json:"rows"`
+			Columns      int  `json:"columns"`
+			Encapsulated bool `json:"encapsulated"`
+		}
+		frames := []frameInfo{}
 
+		// frames are only looked at as the parser hands them over,
+		// none of them get decoded
 		framechan := make(chan *frame.Frame)
 		grp, c := errgroup.WithContext(ctx)
-
+		var dcom dicom.Dataset
 		grp.Go(func() (err error) {
-			_, err = dicom.ParseUntilEOF(file, framechan)
+			dcom, err = dicom.ParseUntilEOF(contextReader{c, file}, framechan, parseOptions()...)
+			if err != nil && c.Err() == nil {
+				parseFailures.Inc()
+				err = pixelDataError(err)
+			}
+			return
+		})
+		grp.Go(func() error {
+			for {
+				select {
+				case <-c.Done():
+					return c.Err()
+				case f, ok := <-framechan:
+					if !ok {
+						return nil
+					}
+					var info frameInfo
+					if f.Encapsulated {
+						info.Encapsulated = true
+					} else {
+						info.Rows, info.Columns = f.NativeData.Rows, f.NativeData.Cols
+					}
+					frames = append(frames, info)
+				}
+			}
+		})
+		err = grp.Wait()
+		if err != nil {
 			return
+		}
+
+		// encapsulated frames don't know their own size until they're
+		// decoded so they go by the dataset's
+		px := datasetPixelFormat(dcom)
+		for i := range frames {
+			if frames[i].Encapsulated {
+				frames[i].Rows, frames[i].Columns = px.rows, px.cols
+			}
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"frameCount":                len(frames),
+			"rows":                      px.rows,
+			"columns":                   px.cols,
+			"bitsAllocated":             px.bitsAllocated,
+			"photometricInterpretation": datasetString(dcom, tag.PhotometricInterpretation),
+			"frames":                    frames,
 		})
+		return
+	}))
+
+	r.GET("/:id/image/histogram", ginfn(func(ctx *gin.Context) (err error) {
+		n, err := queryInt(ctx, "frame", 0)
+		if err != nil {
+			return
+		}
+		bins, err := queryInt(ctx, "bins", defaultHistogramBins)
+		if err != nil {
+			return
+		}
+		if bins < 1 || bins > maxHistogramBins {
+			return NewStatusError(http.StatusBadRequest, fmt.Errorf("invalid bins: must be between 1 and %d", maxHistogramBins))
+		}
+
+		dcom, all, err := frames.load(ctx, storage, ctx.Param("id"))
+		if err != nil {
+			return
+		}
+		if len(all) == 0 {
+			ctx.String(http.StatusNoContent, "no image content found")
+			return
+		}
+		if n >= len(all) {
+			return NewStatusError(http.StatusNotFound, fmt.Errorf("frame %d out of range (frame count: %d)", n, len(all)))
+		}
+		if all[n].Encapsulated {
+			return NewStatusError(http.StatusUnprocessableEntity, errors.New("histograms need native pixel data, transcode the file first"))
+		}
+
+		signed, _ := datasetInt(dcom, tag.PixelRepresentation)
+		h := frameHistogram(all[n].NativeData, signed == 1, bins)
+		h.Frame = n
+		ctx.JSON(http.StatusOK, h)
+		return
+	}))
+
+	// rendering is the expensive part so HEAD only checks the
+	// request would be servable and what it would come back as
+	r.HEAD("/:id/image", ginfn(func(ctx *gin.Context) (err error) {
+		enc, err := negotiateEncoder(ctx)
+		if err != nil {
+			return
+		}
+		dataURI, err := queryDataURI(ctx)
+		if err != nil {
+			return
+		}
+		_, err = storage.Stat(ctx, ctx.Param("id"))
+		if errors.Is(err, fs.ErrNotExist) {
+			err = NewStatusError(http.StatusNotFound, err)
+		}
+		if err != nil {
+			return
+		}
+
+		if dataURI {
+			enc.contentType = "application/json"
+		}
+		ctx.Header("Content-Type", enc.contentType)
+		ctx.Status(http.StatusOK)
+		return
+	}))
+
+	// renderImage sends frame opts.frame of stored file id the way
+	// opts asks for it
+	renderImage := func(ctx *gin.Context, id string, opts imageOptions) (err error) {
+		etag, err := renderETag(ctx, storage, id, opts)
+		if err != nil {
+			return
+		}
+		if notModified(ctx, etag) {
+			ctx.Header("ETag", etag)
+			imageCacheHeaders(ctx)
+			ctx.Status(http.StatusNotModified)
+			return
+		}
+
+		// all a render needs from the dataset comes before the pixel
+		// data, so on a miss that's the header only parse rather than
+		// waiting on the frame parser to get through every frame after
+		// the one asked for, it reads the whole file so one that breaks
+		// after the pixels still fails here, and it's loaded before the
+		// parse slot is taken since it needs one of its own
+		cached, replay, gen, hit := frames.get(id)
+		hdr := cached
+		var file *storedFile
+		if !hit {
+			hdr, err = datasets.load(ctx, storage, id)
+			if err != nil {
+				return
+			}
+			file, err = openFile(ctx, storage, id)
+			if err != nil {
+				return
+			}
+			defer file.Close()
+			release, err := parsers.acquire(ctx)
+			if err != nil {
+				return err
+			}
+			defer release()
+		}
+
+		framechan := make(chan *frame.Frame)
+		grp, c := errgroup.WithContext(ctx)
 
+		// parsed is closed once the parser is done, with parseErr set
+		// before that so whatever waits on it sees how the parse went
+		// without racing the group's cancellation, cached frames get
+		// replayed the same way the parser sends them
+		var parseErr error
+		parsed := make(chan struct{})
 		grp.Go(func() (err error) {
-			f, ok := <-framechan
-			if !ok {
-				ctx.String(http.StatusNoContent, "no image content found")
+			defer close(parsed)
+			defer func() { parseErr = err }()
+			if hit {
+				defer close(framechan)
+				for _, f := range replay {
+					select {
+					case <-c.Done():
+						return c.Err()
+					case framechan <- f:
+					}
+				}
 				return
 			}
 
-			// drain the framechan so it doesn't get
-			// backed up and halt the parser
+			// reading through c stops the parser as soon as the request
+			// is cancelled or the frame's been dealt with badly
+			dcom, err := dicom.ParseUntilEOF(contextReader{c, file}, framechan, parseOptions()...)
+			if err != nil {
+				if c.Err() == nil {
+					parseFailures.Inc()
+				}
+				return pixelDataError(err)
+			}
+			frames.add(id, gen, dcom)
+			return
+		})
+
+		// once the consumer has its frame, or has given up on one, the
+		// rest of framechan gets drained until the parser is done so
+		// it's never left stuck on a send
+		discard := func() {
+			for {
+				select {
+				case <-parsed:
+					return
+				case _, ok := <-framechan:
+					if !ok {
+						return
+					}
+				}
+			}
+		}
+		drain := sync.OnceFunc(func() {
 			grp.Go(func() error {
 				for {
 					select {
+					case <-parsed:
+						return nil
 					case <-c.Done():
+						// the parser gives up at its next read, whatever
+						// it sends until then is thrown away off the
+						// group
+						go discard()
 						return c.Err()
 					case _, ok := <-framechan:
 						if !ok {
@@ -119,27 +1578,208 @@ func run() (err error) {
 					}
 				}
 			})
+		})
 
-			img, err := f.GetImage()
+		grp.Go(func() (err error) {
+			defer drain()
+			start := time.Now()
+			f, count, err := seekFrame(c, framechan, opts.frame)
 			if err != nil {
 				return
 			}
+			drain()
+
+			// no frame means the parser's finished, or about to, and
+			// it's only then that how it went is known
+			if f == nil {
+				select {
+				case <-c.Done():
+					return c.Err()
+				case <-parsed:
+				}
+				if parseErr != nil {
+					return parseErr
+				}
+				if count == 0 {
+					ctx.String(http.StatusNoContent, "no image content found")
+					return
+				}
+				return NewStatusError(http.StatusNotFound, fmt.Errorf("frame %d out of range (frame count: %d)", opts.frame, count))
+			}
+
+			geometryHeaders(ctx, hdr)
+			ctx.Header("ETag", etag)
+			imageCacheHeaders(ctx)
+			parseDesc := ""
+			if hit {
+				parseDesc = "cached"
+			}
+			serverTiming(ctx.Writer.Header(), "parse", parseDesc, time.Since(start))
+
+			if opts.enc.raw {
+				return writeRawFrame(ctx, f)
+			}
 
-			buf := bytes.NewBuffer(nil)
-			err = png.Encode(buf, img)
+			start = time.Now()
+			img, err := frameImage(f, hdr)
 			if err != nil {
-				return
+				return NewStatusError(http.StatusUnprocessableEntity, fmt.Errorf("can't decode frame %d: %w", opts.frame, err))
+			}
+
+			win := opts.win
+			if win == nil {
+				win = datasetWindow(hdr)
+			}
+			if win == nil {
+				win = presets.modality(datasetString(hdr, tag.Modality))
+			}
+			if win != nil {
+				m := rawModality
+				if opts.rescale {
+					m = datasetModality(hdr)
+				}
+				img = win.apply(img, m)
+			}
+			inverted := opts.inverted
+			if inverted == nil {
+				mono1 := strings.TrimSpace(datasetString(hdr, tag.PhotometricInterpretation)) == "MONOCHROME1"
+				inverted = &mono1
+			}
+			if *inverted {
+				bits, ok := datasetInt(hdr, tag.BitsStored)
+				if !ok {
+					bits = 16
+				}
+				img = invert(img, bits)
+			}
+			if opts.cmap != nil {
+				img = applyColormap(img, opts.cmap)
+			}
+			if opts.overlays {
+				img = drawOverlays(img, datasetOverlays(hdr), opts.frame)
+			}
+			if opts.crop != nil {
+				img, err = crop(img, *opts.crop)
+				if err != nil {
+					return
+				}
 			}
+			if opts.maxDim > 0 {
+				img = thumbnail(img, opts.maxDim)
+			}
+			serverTiming(ctx.Writer.Header(), "render", "", time.Since(start))
 
-			ctx.DataFromReader(http.StatusOK, int64(buf.Len()), http.DetectContentType(buf.Bytes()), buf, nil)
+			// stream the encoding out as it's produced rather than
+			// holding the whole image in memory, closing the read end
+			// afterwards unblocks the encoder if sending gave up early,
+			// how long encoding took is only known once it's all sent
+			pr, pw := io.Pipe()
+			// a client that's gone stops the encoder at its next write
+			// rather than whenever writing to the connection fails
+			defer context.AfterFunc(c, func() { pr.CloseWithError(c.Err()) })()
+			encoded := make(chan time.Duration, 1)
+			grp.Go(func() (err error) {
+				start := time.Now()
+				if opts.dataURI {
+					err = writeDataURI(pw, opts.enc, img)
+				} else {
+					err = opts.enc.encode(pw, img)
+				}
+				if err == nil {
+					encoded <- time.Since(start)
+				}
+				pw.CloseWithError(err)
+				return
+			})
+			contentType := opts.enc.contentType
+			if opts.dataURI {
+				contentType = "application/json"
+			}
+			ctx.DataFromReader(http.StatusOK, -1, contentType, pr, nil)
+			pr.Close()
+			select {
+			case d := <-encoded:
+				serverTimingTrailer(ctx.Writer.Header(), "encode", d)
+			default:
+			}
 			return
 		})
 
 		return grp.Wait()
+	}
+
+	r.GET("/:id/image", ginfn(func(ctx *gin.Context) (err error) {
+		opts, err := queryImageOptions(ctx)
+		if err != nil {
+			return
+		}
+		return renderImage(ctx, ctx.Param("id"), opts)
+	}))
+
+	r.GET("/wado", ginfn(func(ctx *gin.Context) (err error) {
+		req, err := queryWADO(ctx)
+		if err != nil {
+			return
+		}
+		inst, ok := index.lookup(req.study, req.series, req.object)
+		if !ok {
+			return NewStatusError(http.StatusNotFound, errors.New("no such instance"))
+		}
+		if !req.raw {
+			return renderImage(ctx, inst.ID, req.opts)
+		}
+
+		file, err := openFile(ctx, storage, inst.ID)
+		if err != nil {
+			return
+		}
+		defer file.Close()
+
+		ctx.Header("Content-Type", "application/dicom")
+		ctx.Header("Accept-Ranges", "bytes")
+		return serveStored(ctx, inst.ID, file)
 	}))
-	return r.Run(":8080")
+
+	r.GET("/openapi.json", func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "application/json", openAPISpec)
+	})
+	err = checkOpenAPI(r.Routes(), "GET /metrics")
+	if err != nil {
+		return
+	}
+
+	// stopped once serve returns, which is after draining
+	ctx, cancel := context.WithCancel(context.Background())
+	if *fileTTL > 0 {
+		go purgeExpired(ctx, storage, locks, *fileTTL, func(id string) {
+			inst, _ := index.get(id)
+			changed(id)
+			index.remove(id)
+			hooks.notify("deleted", id, inst.SOP)
+		})
+	}
+	// events still queued get the same grace requests do to go out
+	stop = func() {
+		cancel()
+		hooks.close(*shutdownGrace)
+	}
+	return r, stop, nil
 }
 
 func main() {
-	log.Fatal(run())
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, nil)))
+	if err := parseFlags(); err != nil {
+		log.Fatal(err)
+	}
+	storage, closeStorage, err := openStorage()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = run(storage)
+	// only once everything, including draining the server, is done
+	// with the files
+	closeStorage()
+	if err != nil {
+		log.Fatal(err)
+	}
 }
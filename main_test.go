@@ -0,0 +1,354 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+const xrayFixture = "data/XRAY/DICOM/PA000001/ST000001/SE000001/IM000001"
+
+func TestMain(m *testing.M) {
+	*ginMode = gin.TestMode
+	os.Exit(m.Run())
+}
+
+// newTestRouter serves a fresh memStorage with the flags left at
+// their defaults, files put in storage first get indexed like they
+// would be on startup
+func newTestRouter(t *testing.T, files map[string]string) (http.Handler, *memStorage) {
+	t.Helper()
+	storage := newMemStorage()
+	for id, path := range files {
+		storage.put(id, readFixture(t, path))
+	}
+	h, stop, err := newRouter(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stop)
+	return h, storage
+}
+
+func readFixture(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// send runs a request through h, header alternates names and values
+func send(h http.Handler, method, target string, body []byte, header ...string) *httptest.ResponseRecorder {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, r)
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeJSON(t *testing.T, rec *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+		t.Fatalf("decoding %q: %v", rec.Body.String(), err)
+	}
+}
+
+func TestStoreFetchDelete(t *testing.T) {
+	h, storage := newTestRouter(t, nil)
+	data := readFixture(t, xrayFixture)
+
+	rec := send(h, http.MethodPut, "/base", data)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("PUT: got %d %s", rec.Code, rec.Body)
+	}
+	var stored struct {
+		ID             string `json:"id"`
+		SOPInstanceUID string `json:"sopInstanceUID"`
+	}
+	decodeJSON(t, rec, &stored)
+	if stored.ID != "base" || stored.SOPInstanceUID == "" {
+		t.Errorf("PUT: got %+v", stored)
+	}
+
+	rec = send(h, http.MethodGet, "/base", nil)
+	if rec.Code != http.StatusOK || !bytes.Equal(rec.Body.Bytes(), data) {
+		t.Fatalf("GET: got %d with %d bytes, want the %d stored", rec.Code, rec.Body.Len(), len(data))
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/dicom" {
+		t.Errorf("GET: Content-Type %q", got)
+	}
+
+	rec = send(h, http.MethodGet, "/", nil)
+	var files []struct {
+		ID   string `json:"id"`
+		Size int64  `json:"size"`
+	}
+	decodeJSON(t, rec, &files)
+	if len(files) != 1 || files[0].ID != "base" || files[0].Size != int64(len(data)) {
+		t.Errorf("listing: got %+v", files)
+	}
+
+	rec = send(h, http.MethodDelete, "/base", nil)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("DELETE: got %d %s", rec.Code, rec.Body)
+	}
+	if rec = send(h, http.MethodGet, "/base", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("GET after DELETE: got %d", rec.Code)
+	}
+	if _, err := storage.Stat(context.Background(), "base"); err == nil {
+		t.Error("file still in storage after DELETE")
+	}
+}
+
+func TestPutSkippingValidation(t *testing.T) {
+	h, _ := newTestRouter(t, nil)
+
+	rec := send(h, http.MethodPut, "/notdicom?skipValidation=true", []byte("not dicom"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	var stored map[string]any
+	decodeJSON(t, rec, &stored)
+	if len(stored) != 1 || stored["id"] != "notdicom" {
+		t.Errorf("got %s, want just the id", rec.Body)
+	}
+}
+
+func TestPutRejectsNonDICOM(t *testing.T) {
+	h, storage := newTestRouter(t, nil)
+
+	rec := send(h, http.MethodPut, "/notdicom", bytes.Repeat([]byte("not dicom "), 100))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("got %d %s, want 400", rec.Code, rec.Body)
+	}
+	if len(storage.files) != 0 {
+		t.Errorf("left %d files behind", len(storage.files))
+	}
+}
+
+func TestTagLookup(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"base": xrayFixture})
+
+	rec := send(h, http.MethodGet, "/base/tag?name=modality", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	// the parser's own fields come along too and VR is one of them,
+	// which a struct would match case insensitively
+	var elem map[string]any
+	decodeJSON(t, rec, &elem)
+	if vals, _ := elem["value"].([]any); elem["vr"] != "CS" || len(vals) != 1 || vals[0] != "DX" {
+		t.Errorf("got %v", elem)
+	}
+
+	rec = send(h, http.MethodGet, "/base/tag?name=Modality&name=PatientMotherBirthName", nil)
+	var several struct {
+		Tags    map[string]any `json:"tags"`
+		Missing []string       `json:"missing"`
+	}
+	decodeJSON(t, rec, &several)
+	if several.Tags["Modality"] == nil || several.Tags["PatientMotherBirthName"] != nil || len(several.Missing) != 1 || several.Missing[0] != "PatientMotherBirthName" {
+		t.Errorf("several names: got %s", rec.Body)
+	}
+
+	if rec = send(h, http.MethodGet, "/missing/tag?name=Modality", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("missing file: got %d", rec.Code)
+	}
+	if rec = send(h, http.MethodGet, "/base/tag?name=NoSuchTag", nil); rec.Code != http.StatusBadRequest {
+		t.Errorf("unknown tag name: got %d", rec.Code)
+	}
+}
+
+func TestHeadDoesNotHash(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"base": xrayFixture})
+
+	rec := send(h, http.MethodHead, "/base", nil)
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("ETag"), `W/"`) {
+		t.Fatalf("cold HEAD: got %d with ETag %q, want a weak one", rec.Code, rec.Header().Get("ETag"))
+	}
+	strong := send(h, http.MethodGet, "/base", nil).Header().Get("ETag")
+	if got := send(h, http.MethodHead, "/base", nil).Header().Get("ETag"); got != strong {
+		t.Errorf("HEAD after GET: got ETag %q, want the hashed %q", got, strong)
+	}
+}
+
+func TestReindex(t *testing.T) {
+	open, _ := newTestRouter(t, nil)
+	if rec := send(open, http.MethodPost, "/admin/reindex", nil); rec.Code != http.StatusForbidden {
+		t.Errorf("without AUTH_TOKEN: got %d", rec.Code)
+	}
+
+	*authToken = "secret"
+	t.Cleanup(func() { *authToken = "" })
+	h, storage := newTestRouter(t, map[string]string{"base": xrayFixture})
+	auth := []string{"Authorization", "Bearer secret"}
+
+	before := send(h, http.MethodGet, "/base/tag?name=SOPInstanceUID", nil, auth...).Body.String()
+	storage.put("base", readFixture(t, "data/LEGACY/implicit-vr.dcm"))
+	if rec := send(h, http.MethodPost, "/admin/reindex", nil, auth...); rec.Code != http.StatusOK {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	if after := send(h, http.MethodGet, "/base/tag?name=SOPInstanceUID", nil, auth...).Body.String(); after == before {
+		t.Errorf("still serving the old file's %s after reindexing", after)
+	}
+}
+
+func TestRange(t *testing.T) {
+	t.Cleanup(func() { *gzipStorage = false })
+	data := readFixture(t, xrayFixture)
+	for _, compressed := range []bool{false, true} {
+		*gzipStorage = compressed
+		h, _ := newTestRouter(t, nil)
+		if rec := send(h, http.MethodPut, "/base", data); rec.Code != http.StatusOK {
+			t.Fatalf("compressed %v, PUT: got %d %s", compressed, rec.Code, rec.Body)
+		}
+
+		rec := send(h, http.MethodGet, "/base", nil, "Range", "bytes=0-1023")
+		if rec.Code != http.StatusPartialContent || !bytes.Equal(rec.Body.Bytes(), data[:1024]) {
+			t.Errorf("compressed %v: got %d with %d bytes, want the first 1024", compressed, rec.Code, rec.Body.Len())
+		}
+		if got, want := rec.Header().Get("Content-Range"), "bytes 0-1023/"+strconv.Itoa(len(data)); got != want {
+			t.Errorf("compressed %v: Content-Range %q, want %q", compressed, got, want)
+		}
+		rec = send(h, http.MethodGet, "/base", nil, "Range", "bytes=-16")
+		if rec.Code != http.StatusPartialContent || !bytes.Equal(rec.Body.Bytes(), data[len(data)-16:]) {
+			t.Errorf("compressed %v, suffix: got %d with %q", compressed, rec.Code, rec.Body)
+		}
+	}
+}
+
+func TestLegacyTransferSyntaxes(t *testing.T) {
+	legacy := map[string]string{
+		"implicit":      "data/LEGACY/implicit-vr.dcm",
+		"bigendian":     "data/LEGACY/big-endian.dcm",
+		"nogrouplength": "data/LEGACY/no-group-length.dcm",
+	}
+	h, _ := newTestRouter(t, legacy)
+
+	// they're all the same image stored three ways, so they should all
+	// read and render the same too
+	var want image.Image
+	for _, id := range []string{"implicit", "bigendian", "nogrouplength"} {
+		rec := send(h, http.MethodGet, "/"+id+"/tag?name=PatientName&name=Rows", nil)
+		var tags struct {
+			Tags map[string]struct {
+				Value []any `json:"value"`
+			} `json:"tags"`
+		}
+		decodeJSON(t, rec, &tags)
+		if name := tags.Tags["PatientName"].Value; len(name) != 1 || name[0] != "NAYYAR^HARSH" {
+			t.Errorf("%s: PatientName %v", id, name)
+		}
+		if rows := tags.Tags["Rows"].Value; len(rows) != 1 || rows[0] != 64.0 {
+			t.Errorf("%s: Rows %v", id, rows)
+		}
+
+		rec = send(h, http.MethodGet, "/"+id+"/image", nil)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s image: got %d %s", id, rec.Code, rec.Body)
+		}
+		img, err := png.Decode(rec.Body)
+		if err != nil {
+			t.Fatalf("%s image: %v", id, err)
+		}
+		if want == nil {
+			want = img
+			continue
+		}
+		if !sameImage(img, want) {
+			t.Errorf("%s renders differently to implicit", id)
+		}
+	}
+}
+
+func sameImage(a, b image.Image) bool {
+	if a.Bounds() != b.Bounds() {
+		return false
+	}
+	for y := a.Bounds().Min.Y; y < a.Bounds().Max.Y; y++ {
+		for x := a.Bounds().Min.X; x < a.Bounds().Max.X; x++ {
+			if a.At(x, y) != b.At(x, y) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestTagValueShapes(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"base": xrayFixture})
+	values := func() map[string][]any {
+		t.Helper()
+		rec := send(h, http.MethodGet, "/base/tag?name=ImageType&name=PixelSpacing&name=InstanceNumber", nil)
+		var tags struct {
+			Tags map[string]struct {
+				Value []any `json:"value"`
+			} `json:"tags"`
+		}
+		decodeJSON(t, rec, &tags)
+		vals := map[string][]any{}
+		for name, elem := range tags.Tags {
+			vals[name] = elem.Value
+		}
+		return vals
+	}
+
+	// a single value is still a list, and the padding that evens out
+	// InstanceNumber's "1" is gone
+	want := map[string][]any{
+		"ImageType":      {"ORIGINAL", "PRIMARY"},
+		"PixelSpacing":   {"0.2", "0.2"},
+		"InstanceNumber": {"1"},
+	}
+	if got := values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if rec := send(h, http.MethodPatch, "/base/tag?name=InstanceNumber", []byte(`{"value":["1","22"]}`)); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH: got %d %s", rec.Code, rec.Body)
+	}
+	want["InstanceNumber"] = []any{"1", "22"}
+	if got := values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("multi-valued IS: got %v, want %v", got, want)
+	}
+}
+
+func TestTranscodeETag(t *testing.T) {
+	h, _ := newTestRouter(t, map[string]string{"implicit": "data/LEGACY/implicit-vr.dcm"})
+	old := send(h, http.MethodGet, "/implicit", nil).Header().Get("ETag")
+
+	if rec := send(h, http.MethodPost, "/implicit/transcode", nil, "If-Match", `"stale"`); rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("stale If-Match: got %d %s", rec.Code, rec.Body)
+	}
+	rec := send(h, http.MethodPost, "/implicit/transcode", nil, "If-Match", old)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	etag := rec.Header().Get("ETag")
+	if etag == "" || etag == old {
+		t.Errorf("ETag %q after transcoding, was %q", etag, old)
+	}
+	if got := send(h, http.MethodGet, "/implicit", nil).Header().Get("ETag"); got != etag {
+		t.Errorf("GET after transcoding: ETag %q, want %q", got, etag)
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memStorage keeps files in memory so handlers can be tested without
+// touching the disk, stored contents are never modified so readers
+// can share them
+type memStorage struct {
+	mu    sync.RWMutex
+	files map[string]*memInfo
+}
+
+func newMemStorage() *memStorage {
+	return &memStorage{files: map[string]*memInfo{}}
+}
+
+// memInfo is a stored file along with what Stat says about it
+type memInfo struct {
+	name    string
+	data    []byte
+	modTime time.Time
+}
+
+func (i *memInfo) Name() string       { return i.name }
+func (i *memInfo) Size() int64        { return int64(len(i.data)) }
+func (i *memInfo) Mode() fs.FileMode  { return 0o644 }
+func (i *memInfo) ModTime() time.Time { return i.modTime }
+func (i *memInfo) IsDir() bool        { return false }
+func (i *memInfo) Sys() any           { return nil }
+
+// memFile reads a snapshot of a stored file
+type memFile struct {
+	*bytes.Reader
+	info *memInfo
+}
+
+func (f memFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f memFile) Close() error               { return nil }
+
+// memWriter buffers a file until it's closed, when it replaces
+// whatever's stored under its name
+type memWriter struct {
+	s    *memStorage
+	name string
+	buf  bytes.Buffer
+}
+
+func (w *memWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *memWriter) Close() error {
+	w.s.put(w.name, w.buf.Bytes())
+	return nil
+}
+
+func (s *memStorage) put(name string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[name] = &memInfo{name: name, data: data, modTime: time.Now()}
+}
+
+func (s *memStorage) get(op, name string) (*memInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	info, ok := s.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+	return info, nil
+}
+
+func (s *memStorage) Open(ctx context.Context, name string) (rawFile, error) {
+	info, err := s.get("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return memFile{bytes.NewReader(info.data), info}, nil
+}
+
+// Create has name exist straight away like a file on disk would,
+// empty until the writer's closed
+func (s *memStorage) Create(ctx context.Context, name string) (io.WriteCloser, error) {
+	if !fs.ValidPath(name) || strings.Contains(name, "/") {
+		return nil, &fs.PathError{Op: "create", Path: name, Err: fs.ErrInvalid}
+	}
+	s.put(name, nil)
+	return &memWriter{s: s, name: name}, nil
+}
+
+func (s *memStorage) Remove(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.files[name]; !ok {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+	delete(s.files, name)
+	return nil
+}
+
+func (s *memStorage) Rename(ctx context.Context, oldname, newname string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, ok := s.files[oldname]
+	if !ok {
+		return &fs.PathError{Op: "rename", Path: oldname, Err: fs.ErrNotExist}
+	}
+	delete(s.files, oldname)
+	s.files[newname] = &memInfo{name: newname, data: info.data, modTime: info.modTime}
+	return nil
+}
+
+func (s *memStorage) Stat(ctx context.Context, name string) (fs.FileInfo, error) {
+	return s.get("stat", name)
+}
+
+func (s *memStorage) FS(ctx context.Context) fs.FS {
+	return memFS{s}
+}
+
+// memFS lists a memStorage, which has no directories besides "."
+type memFS struct {
+	s *memStorage
+}
+
+func (m memFS) Open(name string) (fs.File, error) {
+	return m.s.Open(context.Background(), name)
+}
+
+func (m memFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name != "." {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	m.s.mu.RLock()
+	defer m.s.mu.RUnlock()
+	entries := make([]fs.DirEntry, 0, len(m.s.files))
+	for _, name := range slices.Sorted(maps.Keys(m.s.files)) {
+		entries = append(entries, fs.FileInfoToDirEntry(m.s.files[name]))
+	}
+	return entries, nil
+}
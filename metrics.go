@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Requests handled, by route and status.",
+	}, []string{"method", "route", "status"})
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Time taken to handle requests, by route and status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+	requestRejections = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "http_request_rejections_total",
+		Help: "Requests turned away because too many were already being handled.",
+	})
+	uploadedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dicom_uploaded_bytes_total",
+		Help: "Bytes of files stored, by transfer syntax.",
+	}, []string{"transfer_syntax"})
+	parseFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dicom_parse_failures_total",
+		Help: "Files that failed to parse as dicom.",
+	})
+	parseRejections = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dicom_parse_rejections_total",
+		Help: "Parses turned away because too many were already waiting.",
+	})
+	datasetCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dicom_dataset_cache_hits_total",
+		Help: "Parsed datasets served from the cache.",
+	})
+	datasetCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dicom_dataset_cache_misses_total",
+		Help: "Parsed datasets that had to be read from storage.",
+	})
+	frameCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dicom_frame_cache_hits_total",
+		Help: "Renders served from cached frames.",
+	})
+	frameCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dicom_frame_cache_misses_total",
+		Help: "Renders that had to parse the file for its frames.",
+	})
+	frameCacheBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dicom_frame_cache_bytes",
+		Help: "Estimated memory held by cached frames.",
+	})
+)
+
+// Metrics records the count and latency of each request against the
+// route it matched rather than the raw path, which would blow up the
+// number of series with every id
+func Metrics() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		labels := prometheus.Labels{
+			"method": ctx.Request.Method,
+			"route":  route,
+			"status": strconv.Itoa(ctx.Writer.Status()),
+		}
+		requestsTotal.With(labels).Inc()
+		requestDuration.With(labels).Observe(time.Since(start).Seconds())
+	}
+}
+
+// registerStoredFiles exports how many files are in storage, counted
+// fresh on every scrape
+func registerStoredFiles(storage fileStorage) {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "dicom_stored_files",
+		Help: "Files currently in storage.",
+	}, func() float64 {
+		entries, err := fs.ReadDir(storage.FS(context.Background()), ".")
+		if err != nil {
+			return 0
+		}
+		n := 0
+		for _, entry := range entries {
+			if !strings.HasPrefix(entry.Name(), ".") {
+				n++
+			}
+		}
+		return float64(n)
+	})
+}
+
+// countingReader keeps track of how much has been read through it
+type countingReader struct {
+	io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// recordUpload counts n stored bytes against the transfer syntax of
+// ds, files stored without parsing them have an unknown one
+func recordUpload(ds dicom.Dataset, n int64) {
+	ts := datasetString(ds, tag.TransferSyntaxUID)
+	if ts == "" {
+		ts = "unknown"
+	}
+	uploadedBytes.WithLabelValues(ts).Add(float64(n))
+}
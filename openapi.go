@@ -0,0 +1,68 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// the spec is written by hand, checkOpenAPI keeps it honest about
+// which routes exist
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// checkOpenAPI compares the spec against the routes actually
+// registered, extra are routes served outside of gin, so a route
+// can't be added or dropped without the spec following
+func checkOpenAPI(routes gin.RoutesInfo, extra ...string) error {
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(openAPISpec, &spec); err != nil {
+		return fmt.Errorf("invalid openapi spec: %w", err)
+	}
+
+	var documented []string
+	for p, ops := range spec.Paths {
+		for method := range ops {
+			if method == "parameters" {
+				continue
+			}
+			documented = append(documented, strings.ToUpper(method)+" "+p)
+		}
+	}
+
+	served := slices.Clone(extra)
+	for _, route := range routes {
+		// gin's :param is openapi's {param}
+		parts := strings.Split(route.Path, "/")
+		for i, part := range parts {
+			if name, ok := strings.CutPrefix(part, ":"); ok {
+				parts[i] = "{" + name + "}"
+			}
+		}
+		served = append(served, route.Method+" "+strings.Join(parts, "/"))
+	}
+
+	var problems []string
+	for _, r := range served {
+		if !slices.Contains(documented, r) {
+			problems = append(problems, r+" isn't documented")
+		}
+	}
+	for _, r := range documented {
+		if !slices.Contains(served, r) {
+			problems = append(problems, r+" isn't served")
+		}
+	}
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return fmt.Errorf("openapi spec out of date: %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
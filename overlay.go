@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"golang.org/x/image/draw"
+)
+
+// overlays get drawn in yellow, it stands out against grayscale
+var overlayColor = color.RGBA{R: 0xff, G: 0xff, A: 0xff}
+
+// overlay is one of the bitmap overlay planes in groups 6000-601E,
+// PS3.3 C.9.2
+type overlay struct {
+	rows, cols  int
+	origin      image.Point
+	frames      int
+	frameOrigin int
+	data        []byte
+}
+
+// datasetOverlays collects every overlay plane in ds that carries its
+// own bitmap
+func datasetOverlays(ds dicom.Dataset) []overlay {
+	var overlays []overlay
+	for group := uint16(0x6000); group <= 0x601E; group += 2 {
+		elem, err := ds.FindElementByTag(tag.Tag{Group: group, Element: 0x3000})
+		if err != nil {
+			continue
+		}
+		data, ok := elem.Value.GetValue().([]byte)
+		if !ok {
+			continue
+		}
+
+		o := overlay{data: data, frames: 1, frameOrigin: 1, origin: image.Pt(1, 1)}
+		if v := overlayInts(ds, group, 0x0010); len(v) > 0 {
+			o.rows = v[0]
+		}
+		if v := overlayInts(ds, group, 0x0011); len(v) > 0 {
+			o.cols = v[0]
+		}
+		if v := overlayInts(ds, group, 0x0015); len(v) > 0 {
+			o.frames = v[0]
+		}
+		if v := overlayInts(ds, group, 0x0050); len(v) > 1 {
+			// stored as row then column, one based
+			o.origin = image.Pt(int(int16(v[1])), int(int16(v[0])))
+		}
+		if v := overlayInts(ds, group, 0x0051); len(v) > 0 {
+			o.frameOrigin = v[0]
+		}
+		if o.rows > 0 && o.cols > 0 {
+			overlays = append(overlays, o)
+		}
+	}
+	return overlays
+}
+
+// overlayInts reads the US/SS values of an overlay attribute, which
+// aren't in the dictionary so implicit VR files leave them as bytes
+func overlayInts(ds dicom.Dataset, group, element uint16) []int {
+	elem, err := ds.FindElementByTag(tag.Tag{Group: group, Element: element})
+	if err != nil {
+		return nil
+	}
+	switch v := elem.Value.GetValue().(type) {
+	case []int:
+		return v
+	case []byte:
+		ints := make([]int, len(v)/2)
+		for i := range ints {
+			ints[i] = int(binary.LittleEndian.Uint16(v[2*i:]))
+		}
+		return ints
+	}
+	return nil
+}
+
+// drawOverlays paints the overlay bits that belong to frame n onto
+// img, which comes back unchanged if there aren't any
+func drawOverlays(img image.Image, overlays []overlay, n int) image.Image {
+	if len(overlays) == 0 {
+		return img
+	}
+
+	b := img.Bounds()
+	dst := image.NewRGBA(b)
+	draw.Draw(dst, b, img, b.Min, draw.Src)
+	for _, o := range overlays {
+		f := n - (o.frameOrigin - 1)
+		if f < 0 || f >= o.frames {
+			continue
+		}
+		// the bits are packed back to back across frames, lowest
+		// bit first
+		base := f * o.rows * o.cols
+		for r := range o.rows {
+			for c := range o.cols {
+				bit := base + r*o.cols + c
+				if bit/8 >= len(o.data) || o.data[bit/8]>>(bit%8)&1 == 0 {
+					continue
+				}
+				dst.SetRGBA(b.Min.X+o.origin.X-1+c, b.Min.Y+o.origin.Y-1+r, overlayColor)
+			}
+		}
+	}
+	return dst
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// parseReport says how far a file got through the parser, and where
+// it broke if it did
+type parseReport struct {
+	OK       bool   `json:"ok"`
+	Elements int    `json:"elements"`
+	Error    string `json:"error,omitempty"`
+	// Offset is where the element that failed starts and BrokeAt is
+	// how far into it the parser had read when it gave up
+	Offset      *int64 `json:"offset,omitempty"`
+	BrokeAt     *int64 `json:"brokeAt,omitempty"`
+	Tag         string `json:"tag,omitempty"`
+	LastElement string `json:"lastElement,omitempty"`
+}
+
+// parseCheck parses file element by element to find where it breaks,
+// unlike ParseUntilEOF running out of file partway through an element
+// counts as an error
+func parseCheck(ctx context.Context, file *storedFile) (report parseReport, err error) {
+	release, err := parsers.acquire(ctx)
+	if err != nil {
+		return
+	}
+	defer release()
+
+	size, err := file.Size()
+	if err != nil {
+		return
+	}
+
+	// the parser buffers whatever it's given unless it's a big enough
+	// bufio.Reader already, handing it one means what's been consumed
+	// is what's been counted less what's still buffered
+	counted := &countingReader{Reader: file}
+	br := bufio.NewReader(counted)
+	offset := func() int64 {
+		return counted.n - int64(br.Buffered())
+	}
+	fail := func(start int64, perr error, last *dicom.Element) parseReport {
+		if errors.Is(perr, io.EOF) || errors.Is(perr, io.ErrUnexpectedEOF) {
+			perr = fmt.Errorf("file ends partway through an element: %w", perr)
+		}
+		brokeAt := offset()
+		report.Error = perr.Error()
+		report.Offset, report.BrokeAt = &start, &brokeAt
+		if last != nil {
+			report.LastElement = last.Tag.String()
+		}
+		if t, ok := tagAt(file, start); ok {
+			report.Tag = t.String()
+		}
+		return report
+	}
+
+	if !hasDICOMMagic(br) {
+		report.Error = dicom.ErrorMagicWord.Error()
+		return
+	}
+	p, perr := dicom.NewParser(br, size, nil, parseOptions()...)
+	if perr != nil {
+		return fail(0, perr, nil), nil
+	}
+	meta := p.GetMetadata().Elements
+	report.Elements = len(meta)
+	var last *dicom.Element
+	if len(meta) > 0 {
+		last = meta[len(meta)-1]
+	}
+
+	for {
+		start := offset()
+		elem, perr := p.Next()
+		if errors.Is(perr, dicom.ErrorEndOfDICOM) {
+			report.OK = true
+			return
+		}
+		if perr != nil {
+			return fail(start, perr, last), nil
+		}
+		report.Elements++
+		last = elem
+	}
+}
+
+// tagAt reads the group and element an element starting at offset
+// claims to be, assuming little endian like nearly every file is
+func tagAt(file io.ReaderAt, offset int64) (tag.Tag, bool) {
+	var b [4]byte
+	if _, err := file.ReadAt(b[:], offset); err != nil {
+		return tag.Tag{}, false
+	}
+	return tag.Tag{
+		Group:   binary.LittleEndian.Uint16(b[:2]),
+		Element: binary.LittleEndian.Uint16(b[2:]),
+	}, true
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// parsePool bounds how many files get parsed at once, a parse holds
+// the whole dataset in memory so a burst of them is what runs the
+// service out of it, a nil pool doesn't limit anything
+type parsePool struct {
+	slots   chan struct{}
+	waiting atomic.Int64
+	queue   int64
+}
+
+// parsers is shared by everything that parses, set up by run
+var parsers *parsePool
+
+func newParsePool(workers, queue int) *parsePool {
+	return &parsePool{slots: make(chan struct{}, max(workers, 1)), queue: int64(queue)}
+}
+
+// acquire waits for a free slot, once queue others are waiting there
+// already it turns the parse away with a 503 instead, release has to
+// be called once the parse is done
+func (p *parsePool) acquire(ctx context.Context) (release func(), err error) {
+	if p == nil {
+		return func() {}, nil
+	}
+	select {
+	case p.slots <- struct{}{}:
+		return p.release, nil
+	default:
+	}
+
+	if p.waiting.Add(1) > p.queue {
+		p.waiting.Add(-1)
+		parseRejections.Inc()
+		return nil, NewStatusError(http.StatusServiceUnavailable, errors.New("too many files being parsed, try again shortly"))
+	}
+	defer p.waiting.Add(-1)
+	select {
+	case p.slots <- struct{}{}:
+		return p.release, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (p *parsePool) release() {
+	<-p.slots
+}
+
+// acquireReading is acquire for a parse of r, the slot is given back
+// whenever the parse is waiting on r so an upload trickling in doesn't
+// keep it from anyone, and taken again once there's something to
+// parse, skipping the queue since the parse was already let in
+func (p *parsePool) acquireReading(ctx context.Context, r io.Reader) (pr io.Reader, release func(), err error) {
+	release, err = p.acquire(ctx)
+	if err != nil || p == nil {
+		return r, release, err
+	}
+	s := &slotReader{pool: p, ctx: ctx, r: r, held: true}
+	return s, s.done, nil
+}
+
+type slotReader struct {
+	pool *parsePool
+	ctx  context.Context
+	r    io.Reader
+	held bool
+}
+
+func (s *slotReader) Read(b []byte) (n int, err error) {
+	if s.held {
+		s.pool.release()
+		s.held = false
+	}
+	n, err = s.r.Read(b)
+	select {
+	case s.pool.slots <- struct{}{}:
+		s.held = true
+	case <-s.ctx.Done():
+		return n, s.ctx.Err()
+	}
+	return
+}
+
+func (s *slotReader) done() {
+	if s.held {
+		s.pool.release()
+		s.held = false
+	}
+}
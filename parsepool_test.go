@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newSingleParseRouter is newTestRouter with room for one parse at a
+// time and nothing waiting
+func newSingleParseRouter(t *testing.T) http.Handler {
+	t.Helper()
+	workers, queue := *parseWorkers, *parseQueue
+	*parseWorkers, *parseQueue = 1, 0
+	t.Cleanup(func() { *parseWorkers, *parseQueue = workers, queue })
+	h, _ := newTestRouter(t, nil)
+	return h
+}
+
+func TestParsePoolRejectionIsNotInvalidDICOM(t *testing.T) {
+	h := newSingleParseRouter(t)
+	data := readFixture(t, xrayFixture)
+
+	release, err := parsers.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+	if rec := send(h, http.MethodPost, "/validate", data); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("validating with the pool full: got %d %s, want 503", rec.Code, rec.Body)
+	}
+	if rec := send(h, http.MethodPut, "/base", data); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("storing with the pool full: got %d %s, want 503", rec.Code, rec.Body)
+	}
+}
+
+// checkSlotFreedWhileStalled sends data to target, stalling partway
+// through its header, and fails t if the only parse slot is still
+// taken while the request has nothing to read
+func checkSlotFreedWhileStalled(t *testing.T, h http.Handler, method, target string, data []byte) {
+	t.Helper()
+	pr, pw := io.Pipe()
+	done := make(chan int)
+	go func() {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(method, target, pr))
+		done <- rec.Code
+	}()
+	if _, err := pw.Write(data[:200]); err != nil {
+		t.Fatal(err)
+	}
+
+	// it might take the parse a moment to get to the end of what's
+	// been sent and give the slot up
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		release, err := parsers.acquire(context.Background())
+		if err == nil {
+			release()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s %s: the stalled upload still holds the only parse slot", method, target)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	pw.Write(data[200:])
+	pw.Close()
+	if code := <-done; code != http.StatusOK {
+		t.Errorf("%s %s once it carried on: got %d", method, target, code)
+	}
+}
+
+func TestSlowUploadDoesNotHoldParseSlot(t *testing.T) {
+	h := newSingleParseRouter(t)
+	data := readFixture(t, xrayFixture)
+
+	checkSlotFreedWhileStalled(t, h, http.MethodPost, "/validate", data)
+	checkSlotFreedWhileStalled(t, h, http.MethodPut, "/base", data)
+}
+
+func TestCancelledUploadIsNotInvalidDICOM(t *testing.T) {
+	h, storage := newTestRouter(t, nil)
+	data := readFixture(t, xrayFixture)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodPut, "/base", bytes.NewReader(data)).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code == http.StatusOK || rec.Code == http.StatusBadRequest {
+		t.Errorf("got %d %s, want neither a 200 nor blaming the upload", rec.Code, rec.Body)
+	}
+	if len(storage.files) != 0 {
+		t.Errorf("left %d files behind", len(storage.files))
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"strings"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// frameImage decodes f the way ds says its pixels are laid out,
+// GetImage only ever looks at the first sample of a native frame and
+// leaves jpeg to guess the color space, pixel data that doesn't match
+// what the header says can panic the decoders so that's caught and
+// reported as the file's fault
+func frameImage(f *frame.Frame, ds dicom.Dataset) (img image.Image, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			img, err = nil, fmt.Errorf("pixel data doesn't match the image it describes: %v", r)
+		}
+	}()
+
+	photometric := strings.TrimSpace(datasetString(ds, tag.PhotometricInterpretation))
+	if f.Encapsulated {
+		return decodeJPEGFrame(f.EncapsulatedData.Data, photometric)
+	}
+
+	nf := f.NativeData
+	if len(nf.Data) == 0 || len(nf.Data[0]) != 3 {
+		return f.GetImage()
+	}
+	planar, _ := datasetInt(ds, tag.PlanarConfiguration)
+	bits, ok := datasetInt(ds, tag.BitsStored)
+	if !ok {
+		bits = nf.BitsPerSample
+	}
+	return colorImage(nf, photometric, planar == 1, bits), nil
+}
+
+// colorImage turns a three sample native frame into rgb, planar
+// frames store each sample's plane one after the other but the parser
+// hands them out as if they were interleaved
+func colorImage(nf frame.NativeFrame, photometric string, planar bool, bits int) image.Image {
+	n := len(nf.Data)
+	sample := func(i, s int) uint8 {
+		v := nf.Data[i][s]
+		if planar {
+			k := s*n + i
+			v = nf.Data[k/3][k%3]
+		}
+		return uint8(min(max(v>>max(bits-8, 0), 0), 0xff))
+	}
+
+	// only the full range ybr is used uncompressed, the partial and
+	// subsampled ones come out of jpeg which converts them itself
+	ybr := photometric == "YBR_FULL"
+	img := image.NewRGBA(image.Rect(0, 0, nf.Cols, nf.Rows))
+	for i := range min(n, nf.Rows*nf.Cols) {
+		r, g, b := sample(i, 0), sample(i, 1), sample(i, 2)
+		if ybr {
+			r, g, b = color.YCbCrToRGB(r, g, b)
+		}
+		img.SetRGBA(i%nf.Cols, i/nf.Cols, color.RGBA{r, g, b, 0xff})
+	}
+	return img
+}
+
+// decodeJPEGFrame decodes a jpeg frame, which the decoder takes to be
+// ycbcr unless the stream itself says otherwise, files that call
+// themselves RGB stored their channels without the transform though
+func decodeJPEGFrame(data []byte, photometric string) (image.Image, error) {
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	ycc, ok := img.(*image.YCbCr)
+	if !ok || photometric != "RGB" {
+		return img, nil
+	}
+
+	b := ycc.Bounds()
+	out := image.NewRGBA(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			yi, ci := ycc.YOffset(x, y), ycc.COffset(x, y)
+			out.SetRGBA(x, y, color.RGBA{ycc.Y[yi], ycc.Cb[ci], ycc.Cr[ci], 0xff})
+		}
+	}
+	return out, nil
+}
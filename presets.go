@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// presets are the windows set up ahead of time, loaded once at
+// startup
+var presets *presetConfig
+
+// presetConfig has windows by name, for ?preset=, and by modality,
+// for files that don't suggest a window of their own
+type presetConfig struct {
+	Presets    map[string]presetWindow `json:"presets"`
+	Modalities map[string]presetWindow `json:"modalities"`
+}
+
+type presetWindow struct {
+	WindowCenter float64 `json:"windowCenter"`
+	WindowWidth  float64 `json:"windowWidth"`
+}
+
+// builtinPresets are the usual CT windows in hounsfield units, there
+// are no modality defaults unless they're configured
+var builtinPresets = map[string]presetWindow{
+	"abdomen":     {40, 400},
+	"bone":        {400, 1800},
+	"brain":       {40, 80},
+	"lung":        {-600, 1500},
+	"mediastinum": {50, 350},
+}
+
+// loadWindowPresets reads presets from spec, json as it is or else
+// the name of a file holding it, configured presets are added to the
+// builtin ones and replace any of the same name
+func loadWindowPresets(spec string) (*presetConfig, error) {
+	p := &presetConfig{Presets: maps.Clone(builtinPresets), Modalities: map[string]presetWindow{}}
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return p, nil
+	}
+	data := []byte(spec)
+	if !strings.HasPrefix(spec, "{") {
+		var err error
+		data, err = os.ReadFile(spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var conf presetConfig
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&conf); err != nil {
+		return nil, err
+	}
+	for name, w := range conf.Presets {
+		if w.WindowWidth < 1 {
+			return nil, fmt.Errorf("preset %q needs a windowWidth of at least 1", name)
+		}
+		p.Presets[name] = w
+	}
+	for modality, w := range conf.Modalities {
+		if w.WindowWidth < 1 {
+			return nil, fmt.Errorf("modality %q needs a windowWidth of at least 1", modality)
+		}
+		p.Modalities[strings.ToUpper(modality)] = w
+	}
+	return p, nil
+}
+
+// named looks up a preset asked for by name
+func (p *presetConfig) named(name string) (*window, error) {
+	w, ok := p.Presets[name]
+	if !ok {
+		return nil, NewStatusError(http.StatusBadRequest, fmt.Errorf("unknown preset %q, expected one of %s", name, strings.Join(slices.Sorted(maps.Keys(p.Presets)), ", ")))
+	}
+	return &window{w.WindowCenter, w.WindowWidth}, nil
+}
+
+// modality is the default window for files of a modality, if there's
+// one configured
+func (p *presetConfig) modality(modality string) *window {
+	w, ok := p.Modalities[strings.ToUpper(strings.TrimSpace(modality))]
+	if !ok {
+		return nil
+	}
+	return &window{w.WindowCenter, w.WindowWidth}
+}
+
+// queryPreset reads ?preset=, which stands in for an explicit window
+// so it can't be given along with one
+func queryPreset(ctx *gin.Context, win *window) (*window, error) {
+	name := ctx.Query("preset")
+	if name == "" {
+		return win, nil
+	}
+	if win != nil {
+		return nil, NewStatusError(http.StatusBadRequest, errors.New("preset can't be given along with windowCenter and windowWidth"))
+	}
+	return presets.named(name)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// expired files are looked for at least this often, or every ttl if
+// that's shorter
+const purgeInterval = 10 * time.Minute
+
+// purgeExpired deletes every file that hasn't been written in ttl,
+// checking right away and then periodically until ctx is done, forget
+// is called for each file deleted
+func purgeExpired(ctx context.Context, storage fileStorage, locks *idLocks, ttl time.Duration, forget func(id string)) {
+	tick := time.NewTicker(min(ttl, purgeInterval))
+	defer tick.Stop()
+	for {
+		purge(ctx, storage, locks, ttl, forget)
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+func purge(ctx context.Context, storage fileStorage, locks *idLocks, ttl time.Duration, forget func(id string)) {
+	entries, err := fs.ReadDir(storage.FS(ctx), ".")
+	if err != nil {
+		slog.Error("listing storage for expired files", "error", err)
+		return
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") || !entry.Type().IsRegular() {
+			continue
+		}
+		purgeFile(ctx, storage, locks, entry.Name(), ttl, forget)
+	}
+}
+
+// purgeFile deletes id if it's expired, the age is checked again
+// under its lock so a file that's just been rewritten is left be, one
+// that's being read is left for the next time round
+func purgeFile(ctx context.Context, storage fileStorage, locks *idLocks, id string, ttl time.Duration, forget func(id string)) {
+	unlock, ok := locks.lockUnread(id)
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	info, err := storage.Stat(ctx, id)
+	if err != nil {
+		return
+	}
+	age := time.Since(info.ModTime())
+	if age < ttl {
+		return
+	}
+	err = storage.Remove(ctx, id)
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		slog.Error("purging expired file", "id", id, "error", err)
+		return
+	}
+	forget(id)
+	slog.Info("purged expired file", "id", id, "age", age.Round(time.Second).String())
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestPurgeLeavesFilesBeingRead(t *testing.T) {
+	ctx := context.Background()
+	locks := newIDLocks()
+	mem := newMemStorage()
+	storage := trackReads(mem, locks)
+	data := readFixture(t, xrayFixture)
+	mem.put("base", data)
+	var purged []string
+	forget := func(id string) { purged = append(purged, id) }
+
+	file, err := openFile(ctx, storage, "base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	half := make([]byte, len(data)/2)
+	if _, err = io.ReadFull(file, half); err != nil {
+		t.Fatal(err)
+	}
+	purge(ctx, storage, locks, time.Nanosecond, forget)
+	rest, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("finishing the read after purging: %v", err)
+	}
+	file.Close()
+	if !bytes.Equal(append(half, rest...), data) {
+		t.Error("read came back different after purging")
+	}
+	if len(purged) != 0 {
+		t.Fatalf("purged %v while it was being read", purged)
+	}
+
+	purge(ctx, storage, locks, time.Nanosecond, forget)
+	if len(purged) != 1 || purged[0] != "base" {
+		t.Errorf("purged %v once the read was done, want base", purged)
+	}
+	if _, err := storage.Stat(ctx, "base"); err == nil {
+		t.Error("file still in storage after purging")
+	}
+}
+
+func TestReadWaitsForRemoval(t *testing.T) {
+	locks := newIDLocks()
+	unlock, ok := locks.lockUnread("base")
+	if !ok {
+		t.Fatal("nothing's reading but the removal was refused")
+	}
+	opened := make(chan struct{})
+	go func() {
+		locks.read("base")()
+		close(opened)
+	}()
+	select {
+	case <-opened:
+		t.Fatal("read went ahead during the removal")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-opened:
+	case <-time.After(5 * time.Second):
+		t.Fatal("read still waiting after the removal")
+	}
+	if len(locks.locks) != 0 {
+		t.Errorf("%d locks left behind", len(locks.locks))
+	}
+}
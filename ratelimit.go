@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// clients that haven't been seen for this long get their bucket
+// thrown away, by then it would have refilled anyway
+const rateIdle = 5 * time.Minute
+
+// RateLimit gives each client ip a token bucket and answers 429 once
+// it's empty
+func RateLimit(limit rate.Limit, burst int) gin.HandlerFunc {
+	l := &ipLimiters{limit: limit, burst: burst, clients: map[string]*ipLimiter{}}
+	go l.sweep()
+
+	return func(ctx *gin.Context) {
+		now := time.Now()
+		res := l.get(ctx.ClientIP(), now).ReserveN(now, 1)
+		delay := res.DelayFrom(now)
+		if !res.OK() || delay == 0 {
+			if res.OK() {
+				return
+			}
+			delay = time.Second
+		}
+		res.CancelAt(now)
+
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		ctx.Error(NewStatusError(http.StatusTooManyRequests, errors.New("rate limit exceeded")))
+		ctx.Abort()
+	}
+}
+
+type ipLimiters struct {
+	limit   rate.Limit
+	burst   int
+	mu      sync.Mutex
+	clients map[string]*ipLimiter
+}
+
+type ipLimiter struct {
+	*rate.Limiter
+	seen time.Time
+}
+
+func (l *ipLimiters) get(ip string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	c, ok := l.clients[ip]
+	if !ok {
+		c = &ipLimiter{Limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[ip] = c
+	}
+	c.seen = now
+	return c.Limiter
+}
+
+// sweep drops idle clients so the map doesn't grow with every ip
+// that's ever connected
+func (l *ipLimiters) sweep() {
+	for now := range time.Tick(time.Minute) {
+		l.mu.Lock()
+		for ip, c := range l.clients {
+			if now.Sub(c.seen) > rateIdle {
+				delete(l.clients, ip)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// s3Storage keeps each file as an object named prefix+id in a bucket,
+// credentials and region come from the usual AWS environment
+type s3Storage struct {
+	client   *s3.Client
+	uploader *manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+func newS3Storage(ctx context.Context, bucket, prefix string, pathStyle bool) (*s3Storage, error) {
+	if bucket == "" {
+		return nil, errors.New("S3_BUCKET is required for the s3 backend")
+	}
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = pathStyle
+	})
+	return &s3Storage{
+		client:   client,
+		uploader: manager.NewUploader(client),
+		bucket:   bucket,
+		prefix:   prefix,
+	}, nil
+}
+
+func (s *s3Storage) key(name string) *string {
+	return aws.String(s.prefix + name)
+}
+
+// notFound turns the ways S3 says there's no such key into
+// fs.ErrNotExist
+func notFound(op, name string, err error) error {
+	var nf *types.NotFound
+	var nsk *types.NoSuchKey
+	if errors.As(err, &nf) || errors.As(err, &nsk) {
+		return &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+	return err
+}
+
+// objectInfo is what Stat says about an object, its etag goes in Sys
+// since the modification time is only to the second
+type objectInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	etag    string
+}
+
+func (i objectInfo) Name() string       { return i.name }
+func (i objectInfo) Size() int64        { return i.size }
+func (i objectInfo) Mode() fs.FileMode  { return 0o644 }
+func (i objectInfo) ModTime() time.Time { return i.modTime }
+func (i objectInfo) IsDir() bool        { return false }
+func (i objectInfo) Sys() any           { return storedVersion(i.etag) }
+
+func (s *s3Storage) head(ctx context.Context, name string) (*s3.HeadObjectOutput, error) {
+	out, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: &s.bucket, Key: s.key(name)})
+	if err != nil {
+		return nil, notFound("stat", name, err)
+	}
+	return out, nil
+}
+
+func headInfo(name string, out *s3.HeadObjectOutput) objectInfo {
+	return objectInfo{name, aws.ToInt64(out.ContentLength), aws.ToTime(out.LastModified), aws.ToString(out.ETag)}
+}
+
+func (s *s3Storage) Stat(ctx context.Context, name string) (fs.FileInfo, error) {
+	out, err := s.head(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return headInfo(name, out), nil
+}
+
+// Open reads the object as it was when it was opened, a replacement
+// uploaded since fails the reads rather than mixing the two, reads
+// go through ctx so they're cut off along with the request
+func (s *s3Storage) Open(ctx context.Context, name string) (rawFile, error) {
+	out, err := s.head(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &s3File{
+		s:    s,
+		ctx:  ctx,
+		name: name,
+		etag: out.ETag,
+		info: headInfo(name, out),
+	}, nil
+}
+
+// Create uploads as it's written, in parts once there's enough of it,
+// the object only shows up once the writer's closed
+func (s *s3Storage) Create(ctx context.Context, name string) (io.WriteCloser, error) {
+	pr, pw := io.Pipe()
+	w := &s3Writer{pw: pw, done: make(chan error, 1)}
+	go func() {
+		_, err := s.uploader.Upload(ctx, &s3.PutObjectInput{Bucket: &s.bucket, Key: s.key(name), Body: pr})
+		// a failed upload stops taking writes
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+	return w, nil
+}
+
+// Stage uploads straight to name, S3 only has the object show up once
+// the upload's complete so there's no need for a copy out of a scratch
+// object, aborting cuts the upload off before it completes
+func (s *s3Storage) Stage(ctx context.Context, name string) (stagedFile, error) {
+	w, err := s.Create(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return w.(*s3Writer), nil
+}
+
+// checkReady only asks after the bucket, writing to it on every probe
+// would cost a request and leave objects to clean up
+func (s *s3Storage) checkReady(ctx context.Context) error {
+	_, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: &s.bucket})
+	return err
+}
+
+// Remove checks the object's there first since S3 deletes missing
+// keys without complaint
+func (s *s3Storage) Remove(ctx context.Context, name string) error {
+	if _, err := s.head(ctx, name); err != nil {
+		return notFound("remove", name, err)
+	}
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: &s.bucket, Key: s.key(name)})
+	return err
+}
+
+// Rename copies the object and deletes the original, S3 has no moves
+// but the copy replaces newname in one go, it's only for files whose
+// name isn't known until they're written
+func (s *s3Storage) Rename(ctx context.Context, oldname, newname string) error {
+	source := s.bucket + "/" + (&url.URL{Path: s.prefix + oldname}).EscapedPath()
+	_, err := s.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     &s.bucket,
+		Key:        s.key(newname),
+		CopySource: &source,
+	})
+	if err != nil {
+		return notFound("rename", oldname, err)
+	}
+	_, err = s.client.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: &s.bucket, Key: s.key(oldname)})
+	return err
+}
+
+func (s *s3Storage) FS(ctx context.Context) fs.FS {
+	return s3FS{s, ctx}
+}
+
+// s3FS lists the objects right under the prefix
+type s3FS struct {
+	s   *s3Storage
+	ctx context.Context
+}
+
+func (f s3FS) Open(name string) (fs.File, error) {
+	return f.s.Open(f.ctx, name)
+}
+
+func (f s3FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name != "." {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	// keys come back in order, which is the order os.ReadDir gives
+	var entries []fs.DirEntry
+	pages := s3.NewListObjectsV2Paginator(f.s.client, &s3.ListObjectsV2Input{
+		Bucket:    &f.s.bucket,
+		Prefix:    &f.s.prefix,
+		Delimiter: aws.String("/"),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(f.ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range page.Contents {
+			name := strings.TrimPrefix(aws.ToString(obj.Key), f.s.prefix)
+			entries = append(entries, fs.FileInfoToDirEntry(objectInfo{name, aws.ToInt64(obj.Size), aws.ToTime(obj.LastModified), aws.ToString(obj.ETag)}))
+		}
+	}
+	return entries, nil
+}
+
+// s3Writer feeds an upload running in the background
+type s3Writer struct {
+	pw       *io.PipeWriter
+	done     chan error
+	finished bool
+}
+
+// errUploadAborted is what an aborted upload is cut off with
+var errUploadAborted = errors.New("upload aborted")
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// Close finishes the upload and waits for it to land
+func (w *s3Writer) Close() error {
+	w.finished = true
+	w.pw.Close()
+	return <-w.done
+}
+
+func (w *s3Writer) Commit() error {
+	return w.Close()
+}
+
+// Abort fails the upload's body so it never completes, a multipart
+// upload is aborted by the uploader along with the parts it sent
+func (w *s3Writer) Abort() {
+	if w.finished {
+		return
+	}
+	w.finished = true
+	w.pw.CloseWithError(errUploadAborted)
+	<-w.done
+}
+
+// s3File reads an object with ranged GETs, reads carrying on from
+// where the last one stopped keep using the same response so reading
+// straight through is a single request
+type s3File struct {
+	s    *s3Storage
+	ctx  context.Context
+	name string
+	etag *string
+	info objectInfo
+
+	mu  sync.Mutex
+	pos int64
+	// body is the open response, reading from at
+	body io.ReadCloser
+	at   int64
+}
+
+// readFrom reads into p from off, reopening the response if it isn't
+// already there
+func (f *s3File) readFrom(p []byte, off int64) (int, error) {
+	if off >= f.info.size {
+		return 0, io.EOF
+	}
+	if f.body == nil || f.at != off {
+		if f.body != nil {
+			f.body.Close()
+		}
+		out, err := f.s.client.GetObject(f.ctx, &s3.GetObjectInput{
+			Bucket:  &f.s.bucket,
+			Key:     f.s.key(f.name),
+			Range:   aws.String(fmt.Sprintf("bytes=%d-", off)),
+			IfMatch: f.etag,
+		})
+		if err != nil {
+			f.body = nil
+			return 0, notFound("read", f.name, err)
+		}
+		f.body, f.at = out.Body, off
+	}
+	n, err := f.body.Read(p)
+	f.at += int64(n)
+	if errors.Is(err, io.EOF) && f.at < f.info.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (f *s3File) Read(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n, err := f.readFrom(p, f.pos)
+	f.pos += int64(n)
+	return n, err
+}
+
+func (f *s3File) ReadAt(p []byte, off int64) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var n int
+	for n < len(p) {
+		m, err := f.readFrom(p[n:], off+int64(n))
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (f *s3File) Seek(offset int64, whence int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.pos
+	case io.SeekEnd:
+		offset += f.info.size
+	}
+	if offset < 0 {
+		return 0, errors.New("seek before start of file")
+	}
+	f.pos = offset
+	return offset, nil
+}
+
+func (f *s3File) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *s3File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.body == nil {
+		return nil
+	}
+	err := f.body.Close()
+	f.body = nil
+	return err
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// tlsConfig sets up https from either a certificate and key on disk
+// or let's encrypt, nil means plain http, with let's encrypt there's
+// also a server for the challenges to run alongside
+func tlsConfig() (cfg *tls.Config, challenges *http.Server, err error) {
+	switch {
+	case (*tlsCert == "") != (*tlsKey == ""):
+		return nil, nil, errors.New("a tls certificate and key have to be given together")
+	case *tlsCert != "" && *autocertDomain != "":
+		return nil, nil, errors.New("tls certificates and autocert can't be used together")
+	case *tlsCert != "":
+		cert, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &tls.Config{Certificates: []tls.Certificate{cert}}, nil, nil
+	case *autocertDomain != "":
+		dir := *autocertCache
+		if dir == "" {
+			cache, err := os.UserCacheDir()
+			if err != nil {
+				return nil, nil, err
+			}
+			dir = filepath.Join(cache, "dicomserving", "autocert")
+		}
+		// the tls-alpn challenge only works when -addr is :443 itself,
+		// the http one gets answered on port 80 whatever -addr is
+		m := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(strings.Split(*autocertDomain, ",")...),
+			Cache:      autocert.DirCache(dir),
+		}
+		challenges = &http.Server{Addr: *autocertHTTP, Handler: m.HTTPHandler(nil), ReadHeaderTimeout: 10 * time.Second}
+		return m.TLSConfig(), challenges, nil
+	}
+	return nil, nil, nil
+}
+
+// protocols are what the server speaks, http/2 comes with tls anyway
+// and h2c adds it to plain http, only for clients that open with it
+// since there's no upgrading to it from http/1.1
+func protocols() *http.Protocols {
+	var p http.Protocols
+	p.SetHTTP1(true)
+	p.SetHTTP2(true)
+	p.SetUnencryptedHTTP2(*h2c)
+	return &p
+}
+
+// serve runs srvs until SIGINT or SIGTERM or one of them fails, then
+// stops taking new connections and gives the ones in flight up to
+// grace to finish
+func serve(grace time.Duration, srvs ...*http.Server) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errc := make(chan error, len(srvs))
+	for _, srv := range srvs {
+		go func() {
+			if srv.TLSConfig != nil {
+				errc <- srv.ListenAndServeTLS("", "")
+				return
+			}
+			errc <- srv.ListenAndServe()
+		}()
+	}
+
+	select {
+	case err := <-errc:
+		for _, srv := range srvs {
+			srv.Close()
+		}
+		return err
+	case <-ctx.Done():
+	}
+	// a second signal kills us the usual way
+	stop()
+
+	slog.Info("shutting down", "grace", grace.String())
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+	var errs []error
+	for _, srv := range srvs {
+		err := srv.Shutdown(ctx)
+		if errors.Is(err, context.DeadlineExceeded) {
+			srv.Close()
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
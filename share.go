@@ -0,0 +1,64 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sharedKey marks a request let in on a signed url, for BearerAuth to
+// wave through
+const sharedKey = "shared"
+
+// shareToken signs id along with when the link stops working, nothing
+// needs storing since the key can check it again
+func shareToken(key []byte, id string, expires int64) string {
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "%s\n%d", id, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// shareURL links to id for anyone holding it until expires
+func shareURL(ctx *gin.Context, key []byte, id string, expires time.Time) string {
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("token", shareToken(key, id, expires.Unix()))
+	return baseURL(ctx) + "/" + url.PathEscape(id) + "?" + q.Encode()
+}
+
+// SignedURLs lets GET and HEAD requests for an id through on a token
+// signed for it, in place of the usual auth, a token that's expired or
+// doesn't check out is turned away rather than falling back on auth
+func SignedURLs(key []byte) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		token, expires := ctx.Query("token"), ctx.Query("expires")
+		if token == "" && expires == "" {
+			return
+		}
+		if ctx.Request.Method != http.MethodGet && ctx.Request.Method != http.MethodHead {
+			return
+		}
+
+		id, _ := ctx.Params.Get("id")
+		exp, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil || id == "" || !hmac.Equal([]byte(token), []byte(shareToken(key, id, exp))) {
+			ctx.Error(NewStatusError(http.StatusForbidden, errors.New("invalid share token")))
+			ctx.Abort()
+			return
+		}
+		if time.Now().Unix() > exp {
+			ctx.Error(NewStatusError(http.StatusForbidden, errors.New("share link has expired")))
+			ctx.Abort()
+			return
+		}
+		ctx.Set(sharedKey, true)
+	}
+}
@@ -0,0 +1,410 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/rand/v2"
+	"os"
+	"strings"
+	"sync"
+)
+
+// fileStorage is wherever the files live, flat and named by id, with
+// dotfiles for our own scratch files, errors for missing names match
+// fs.ErrNotExist the way the os ones do, ctx is for backends that go
+// over the network and is the request's wherever there is one
+type fileStorage interface {
+	Open(ctx context.Context, name string) (rawFile, error)
+	Create(ctx context.Context, name string) (io.WriteCloser, error)
+	Remove(ctx context.Context, name string) error
+	// Rename replaces newname if it's there already, in one go
+	Rename(ctx context.Context, oldname, newname string) error
+	Stat(ctx context.Context, name string) (fs.FileInfo, error)
+	// FS lists what's stored, at "."
+	FS(ctx context.Context) fs.FS
+}
+
+// rawFile is a stored file as it is in storage, before any
+// decompressing
+type rawFile interface {
+	io.ReadSeekCloser
+	io.ReaderAt
+	Stat() (fs.FileInfo, error)
+}
+
+// storedVersion is what a backend's FileInfo.Sys gives when it has a
+// better way of telling versions of a file apart than its size and
+// modification time
+type storedVersion string
+
+// fileVersion tells apart what's been stored under a name over time
+// without reading it
+func fileVersion(info fs.FileInfo) string {
+	if v, ok := info.Sys().(storedVersion); ok {
+		return string(v)
+	}
+	return fmt.Sprintf("%d-%d", info.Size(), info.ModTime().UnixNano())
+}
+
+// diskStorage keeps files in a directory on the local disk
+type diskStorage struct {
+	root *os.Root
+}
+
+func (d diskStorage) Open(ctx context.Context, name string) (rawFile, error) {
+	return d.root.Open(name)
+}
+
+func (d diskStorage) Create(ctx context.Context, name string) (io.WriteCloser, error) {
+	return d.root.Create(name)
+}
+
+func (d diskStorage) Remove(ctx context.Context, name string) error {
+	return d.root.Remove(name)
+}
+
+func (d diskStorage) Rename(ctx context.Context, oldname, newname string) error {
+	return d.root.Rename(oldname, newname)
+}
+
+func (d diskStorage) Stat(ctx context.Context, name string) (fs.FileInfo, error) {
+	return d.root.Stat(name)
+}
+
+func (d diskStorage) FS(ctx context.Context) fs.FS {
+	return d.root.FS()
+}
+
+// openStorage sets up the backend the flags ask for, close is for
+// once nothing's using it anymore
+func openStorage() (storage fileStorage, close func(), err error) {
+	switch *storageBackend {
+	case "disk":
+	case "s3":
+		s3, err := newS3Storage(context.Background(), *s3Bucket, *s3Prefix, *s3PathStyle)
+		if err != nil {
+			return nil, nil, err
+		}
+		return s3, func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown STORAGE_BACKEND %q", *storageBackend)
+	}
+
+	dir := *storageDir
+	cleanup := func() {}
+	if dir == "" {
+		dir, err = os.MkdirTemp(os.TempDir(), "dicomserving")
+		if err != nil {
+			return
+		}
+		cleanup = func() { os.RemoveAll(dir) }
+	} else if err = os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return diskStorage{root: root}, func() {
+		root.Close()
+		cleanup()
+	}, nil
+}
+
+// readyChecker is a backend that has a cheaper way to tell it's
+// usable than writing to it
+type readyChecker interface {
+	checkReady(ctx context.Context) error
+}
+
+// checkWritable proves the storage volume is mounted read-write by
+// round tripping a scratch file through it
+func checkWritable(ctx context.Context, storage fileStorage) error {
+	if c, ok := storage.(readyChecker); ok {
+		return c.checkReady(ctx)
+	}
+	name := fmt.Sprintf(".readyz-%d", rand.Uint64())
+	file, err := storage.Create(ctx, name)
+	if err != nil {
+		return err
+	}
+	err = file.Close()
+	if rerr := storage.Remove(ctx, name); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// contextReader stops reading once ctx is done, so an upload whose
+// client has gone away fails instead of waiting on a body that's
+// never going to finish
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
+
+// scratchName is a fresh dotfile, which stays out of listings until
+// it's renamed into place
+func scratchName() string {
+	return fmt.Sprintf(".upload-%d", rand.Uint64())
+}
+
+// writeScratch copies r into a fresh scratch file in storage
+func writeScratch(ctx context.Context, storage fileStorage, r io.Reader) (name string, err error) {
+	name = scratchName()
+	file, err := storage.Create(ctx, name)
+	if err != nil {
+		return
+	}
+	_, err = io.Copy(file, r)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		storage.Remove(context.WithoutCancel(ctx), name)
+	}
+	return
+}
+
+// stagedFile is a new version of a file being written, it only takes
+// the place of whatever's stored under its name once it's committed
+// so readers never see it half written, aborting leaves the old file
+// be and does nothing after a commit
+type stagedFile interface {
+	io.Writer
+	Commit() error
+	Abort()
+}
+
+// stager is a backend that can stage files by itself, any other gets
+// a scratch file that's renamed into place
+type stager interface {
+	Stage(ctx context.Context, name string) (stagedFile, error)
+}
+
+// stage starts writing a new version of name
+func stage(ctx context.Context, storage fileStorage, name string) (stagedFile, error) {
+	if s, ok := storage.(stager); ok {
+		return s.Stage(ctx, name)
+	}
+	// scratch files are out of sight already so they're written
+	// where they are
+	scratch := name
+	if !strings.HasPrefix(name, ".") {
+		scratch = scratchName()
+	}
+	file, err := storage.Create(ctx, scratch)
+	if err != nil {
+		return nil, err
+	}
+	return &scratchFile{WriteCloser: file, ctx: ctx, storage: storage, scratch: scratch, name: name}, nil
+}
+
+// scratchFile stages a file as a scratch file alongside it
+type scratchFile struct {
+	io.WriteCloser
+	ctx     context.Context
+	storage fileStorage
+	scratch string
+	name    string
+	done    bool
+}
+
+func (f *scratchFile) Commit() error {
+	f.done = true
+	err := f.Close()
+	if err == nil && f.scratch != f.name {
+		err = f.storage.Rename(f.ctx, f.scratch, f.name)
+	}
+	if err != nil {
+		f.storage.Remove(context.WithoutCancel(f.ctx), f.scratch)
+	}
+	return err
+}
+
+// Abort cleans up even once the request's gone, which is usually why
+// the file's being abandoned
+func (f *scratchFile) Abort() {
+	if f.done {
+		return
+	}
+	f.done = true
+	f.Close()
+	f.storage.Remove(context.WithoutCancel(f.ctx), f.scratch)
+}
+
+// writeUpload copies r into w, compressed if storage is
+func writeUpload(w io.Writer, r io.Reader) error {
+	return compressing(func(w io.Writer) error {
+		_, err := io.Copy(w, r)
+		return err
+	})(w)
+}
+
+// replaceFile swaps in a new version of id in one go so readers only
+// ever see the old file or the finished new one
+func replaceFile(ctx context.Context, storage fileStorage, id string, write func(io.Writer) error) error {
+	file, err := stage(ctx, storage, id)
+	if err != nil {
+		return err
+	}
+	defer file.Abort()
+	if err = compressing(write)(file); err != nil {
+		return err
+	}
+	return file.Commit()
+}
+
+// idLocks serializes writers to the same id and keeps the purge off
+// files that are being read, locks are created on demand and dropped
+// as soon as nobody holds them
+type idLocks struct {
+	mu    sync.Mutex
+	locks map[string]*idLock
+}
+
+type idLock struct {
+	sync.Mutex
+	refs    int
+	readers int
+	// set while the file's being purged, closed once it's gone
+	removing chan struct{}
+}
+
+func newIDLocks() *idLocks {
+	return &idLocks{locks: map[string]*idLock{}}
+}
+
+// getLocked finds id's lock, or makes it, with a ref held on it
+func (l *idLocks) getLocked(id string) *idLock {
+	lk, ok := l.locks[id]
+	if !ok {
+		lk = &idLock{}
+		l.locks[id] = lk
+	}
+	lk.refs++
+	return lk
+}
+
+func (l *idLocks) releaseLocked(id string, lk *idLock) {
+	lk.refs--
+	if lk.refs == 0 {
+		delete(l.locks, id)
+	}
+}
+
+// lock blocks until the caller has id to itself
+func (l *idLocks) lock(id string) (unlock func()) {
+	l.mu.Lock()
+	lk := l.getLocked(id)
+	l.mu.Unlock()
+
+	lk.Lock()
+	return func() {
+		lk.Unlock()
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.releaseLocked(id, lk)
+	}
+}
+
+// read marks id as being read until done is called, it doesn't wait
+// on writers since they replace files whole, only on a removal that's
+// already started
+func (l *idLocks) read(id string) (done func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for {
+		lk := l.getLocked(id)
+		if lk.removing == nil {
+			lk.readers++
+			return func() {
+				l.mu.Lock()
+				defer l.mu.Unlock()
+				lk.readers--
+				l.releaseLocked(id, lk)
+			}
+		}
+		removing := lk.removing
+		l.releaseLocked(id, lk)
+		l.mu.Unlock()
+		<-removing
+		l.mu.Lock()
+	}
+}
+
+// lockUnread is lock for removing id, which fails rather than wait
+// when id is being read, readers that come along after it's taken
+// wait for the removal instead so they never see a file disappear
+// partway through, waiting on readers could deadlock one that opens
+// the same file twice
+func (l *idLocks) lockUnread(id string) (unlock func(), ok bool) {
+	unlockWriters := l.lock(id)
+	l.mu.Lock()
+	lk := l.locks[id]
+	if lk.readers > 0 {
+		l.mu.Unlock()
+		unlockWriters()
+		return nil, false
+	}
+	removing := make(chan struct{})
+	lk.removing = removing
+	l.mu.Unlock()
+	return func() {
+		l.mu.Lock()
+		lk.removing = nil
+		l.mu.Unlock()
+		close(removing)
+		unlockWriters()
+	}, true
+}
+
+// readTracking is storage that marks each file it opens as being read
+// in locks until it's closed
+type readTracking struct {
+	fileStorage
+	locks *idLocks
+}
+
+func trackReads(storage fileStorage, locks *idLocks) fileStorage {
+	return readTracking{storage, locks}
+}
+
+func (s readTracking) Open(ctx context.Context, name string) (rawFile, error) {
+	done := s.locks.read(name)
+	file, err := s.fileStorage.Open(ctx, name)
+	if err != nil {
+		done()
+		return nil, err
+	}
+	return &trackedFile{rawFile: file, done: sync.OnceFunc(done)}, nil
+}
+
+// Stage and checkReady are passed on since the wrapping hides them
+func (s readTracking) Stage(ctx context.Context, name string) (stagedFile, error) {
+	return stage(ctx, s.fileStorage, name)
+}
+
+func (s readTracking) checkReady(ctx context.Context) error {
+	return checkWritable(ctx, s.fileStorage)
+}
+
+type trackedFile struct {
+	rawFile
+	done func()
+}
+
+func (f *trackedFile) Close() error {
+	defer f.done()
+	return f.rawFile.Close()
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Timeout gives each request d to finish, after which its context is
+// cancelled so uploads stop copying and parsers stop reading, a
+// request that ran out of time without answering gets a 408
+func Timeout(d time.Duration) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		c, cancel := context.WithTimeout(ctx.Request.Context(), d)
+		defer cancel()
+		ctx.Request = ctx.Request.WithContext(c)
+		ctx.Next()
+
+		if errors.Is(c.Err(), context.DeadlineExceeded) && !ctx.Writer.Written() {
+			// whatever's left of the body is abandoned, and the server
+			// would otherwise sit reading it out before answering
+			ctx.Header("Connection", "close")
+			ctx.Error(NewStatusError(http.StatusRequestTimeout, errors.New("request timed out")))
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// serverTiming adds a metric to the Server-Timing header, in the
+// milliseconds browsers' dev tools expect, desc is left out when empty
+func serverTiming(h http.Header, name, desc string, d time.Duration) {
+	h.Add("Server-Timing", timingMetric(name, desc, d))
+}
+
+// serverTimingTrailer is serverTiming for a metric that's only known
+// once the body's out, which responses streamed without a length can
+// still send as a trailer
+func serverTimingTrailer(h http.Header, name string, d time.Duration) {
+	h.Add(http.TrailerPrefix+"Server-Timing", timingMetric(name, "", d))
+}
+
+func timingMetric(name, desc string, d time.Duration) string {
+	metric := fmt.Sprintf("%s;dur=%.1f", name, float64(d.Microseconds())/1000)
+	if desc != "" {
+		metric += fmt.Sprintf(";desc=%q", desc)
+	}
+	return metric
+}
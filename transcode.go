@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"net/http"
+	"strings"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+// compressed transfer syntaxes we know how to decode
+const (
+	jpegBaselineUID = "1.2.840.10008.1.2.4.50"
+	rleLosslessUID  = "1.2.840.10008.1.2.5"
+)
+
+// pixelFormat is the image pixel module attributes needed to make
+// sense of raw pixel data
+type pixelFormat struct {
+	rows, cols    int
+	samples       int
+	bitsAllocated int
+}
+
+func datasetPixelFormat(ds dicom.Dataset) pixelFormat {
+	var px pixelFormat
+	px.rows, _ = datasetInt(ds, tag.Rows)
+	px.cols, _ = datasetInt(ds, tag.Columns)
+	px.samples, _ = datasetInt(ds, tag.SamplesPerPixel)
+	px.bitsAllocated, _ = datasetInt(ds, tag.BitsAllocated)
+	return px
+}
+
+// transcode converts ds to explicit vr little endian, decompressing
+// the pixel data on the way if it's in a syntax we can decode
+func transcode(ds *dicom.Dataset) (err error) {
+	switch ts := datasetString(*ds, tag.TransferSyntaxUID); ts {
+	case uid.ImplicitVRLittleEndian, uid.ExplicitVRLittleEndian, uid.ExplicitVRBigEndian:
+		// the parser has already decoded native pixel data
+	case jpegBaselineUID:
+		err = decompress(ds, decodeJPEG)
+	case rleLosslessUID:
+		err = decompress(ds, decodeRLE)
+	default:
+		err = NewStatusError(http.StatusUnsupportedMediaType, fmt.Errorf("can't transcode from %s", uid.UIDString(ts)))
+	}
+	if err != nil {
+		return
+	}
+
+	_, err = setElement(ds, tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian})
+	return
+}
+
+// decompress runs every encapsulated frame through decode and swaps
+// in the result as native pixel data
+func decompress(ds *dicom.Dataset, decode func(*dicom.Dataset, []byte, pixelFormat) (frame.NativeFrame, error)) error {
+	elem, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil {
+		return nil
+	}
+	info := dicom.MustGetPixelDataInfo(elem.Value)
+	px := datasetPixelFormat(*ds)
+
+	frames := make([]*frame.Frame, len(info.Frames))
+	for i, f := range info.Frames {
+		native, err := decode(ds, f.EncapsulatedData.Data, px)
+		if err != nil {
+			return NewStatusError(http.StatusUnprocessableEntity, fmt.Errorf("frame %d: %w", i, err))
+		}
+		frames[i] = &frame.Frame{NativeData: native}
+	}
+	if len(frames) == 0 {
+		return nil
+	}
+
+	elem, err = setElement(ds, tag.PixelData, dicom.PixelDataInfo{Frames: frames})
+	if err != nil {
+		return err
+	}
+	if frames[0].NativeData.BitsPerSample == 8 {
+		elem.RawValueRepresentation = "OB"
+	}
+	return nil
+}
+
+// decodeJPEG decodes a baseline jpeg frame, which always comes out as
+// 8 bit grayscale or rgb so the pixel module gets updated to match
+func decodeJPEG(ds *dicom.Dataset, data []byte, px pixelFormat) (native frame.NativeFrame, err error) {
+	img, err := decodeJPEGFrame(data, strings.TrimSpace(datasetString(*ds, tag.PhotometricInterpretation)))
+	if err != nil {
+		return
+	}
+
+	b := img.Bounds()
+	native = frame.NativeFrame{Rows: b.Dy(), Cols: b.Dx(), BitsPerSample: 8}
+	gray, isGray := img.(*image.Gray)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if isGray {
+				native.Data = append(native.Data, []int{int(gray.GrayAt(x, y).Y)})
+				continue
+			}
+			r, g, b, _ := img.At(x, y).RGBA()
+			native.Data = append(native.Data, []int{int(r >> 8), int(g >> 8), int(b >> 8)})
+		}
+	}
+
+	samples, photometric := 1, "MONOCHROME2"
+	if !isGray {
+		samples, photometric = 3, "RGB"
+	}
+	for t, v := range map[tag.Tag][]int{
+		tag.SamplesPerPixel: {samples},
+		tag.BitsAllocated:   {8},
+		tag.BitsStored:      {8},
+		tag.HighBit:         {7},
+	} {
+		if _, err = setElement(ds, t, v); err != nil {
+			return
+		}
+	}
+	if !isGray {
+		if _, err = setElement(ds, tag.PlanarConfiguration, []int{0}); err != nil {
+			return
+		}
+	}
+	if p := datasetString(*ds, tag.PhotometricInterpretation); p != "MONOCHROME1" || !isGray {
+		_, err = setElement(ds, tag.PhotometricInterpretation, []string{photometric})
+	}
+	return
+}
+
+// decodeRLE unpacks a PS3.5 Annex G rle frame, where every byte of
+// every sample is its own segment, most significant byte first
+func decodeRLE(ds *dicom.Dataset, data []byte, px pixelFormat) (native frame.NativeFrame, err error) {
+	if len(data) < 64 {
+		return native, errors.New("rle header truncated")
+	}
+	bytesPerSample := px.bitsAllocated / 8
+	segments := int(binary.LittleEndian.Uint32(data))
+	if bytesPerSample == 0 || segments == 0 || segments > 15 || segments != px.samples*bytesPerSample {
+		return native, fmt.Errorf("rle frame has %d segments, expected %d", segments, px.samples*bytesPerSample)
+	}
+
+	pixels := px.rows * px.cols
+	native = frame.NativeFrame{Rows: px.rows, Cols: px.cols, BitsPerSample: px.bitsAllocated}
+	native.Data = make([][]int, pixels)
+	for i := range native.Data {
+		native.Data[i] = make([]int, px.samples)
+	}
+
+	for s := range segments {
+		start := int(binary.LittleEndian.Uint32(data[4+4*s:]))
+		end := len(data)
+		if s+1 < segments {
+			end = int(binary.LittleEndian.Uint32(data[4+4*(s+1):]))
+		}
+		if start < 64 || start > end || end > len(data) {
+			return native, fmt.Errorf("rle segment %d out of bounds", s)
+		}
+
+		plane, err := unpackBits(data[start:end], pixels)
+		if err != nil {
+			return native, fmt.Errorf("rle segment %d: %w", s, err)
+		}
+		sample, shift := s/bytesPerSample, 8*(bytesPerSample-1-s%bytesPerSample)
+		for i, v := range plane {
+			native.Data[i][sample] |= int(v) << shift
+		}
+	}
+	return
+}
+
+// unpackBits expands a packbits segment into n bytes
+func unpackBits(src []byte, n int) ([]byte, error) {
+	dst := make([]byte, 0, n)
+	for i := 0; i < len(src) && len(dst) < n; {
+		c := int(int8(src[i]))
+		i++
+		switch {
+		case c >= 0:
+			if i+c+1 > len(src) {
+				return nil, errors.New("literal run truncated")
+			}
+			dst = append(dst, src[i:i+c+1]...)
+			i += c + 1
+		case c > -128:
+			if i >= len(src) {
+				return nil, errors.New("replicate run truncated")
+			}
+			dst = append(dst, bytes.Repeat(src[i:i+1], 1-c)...)
+			i++
+		}
+	}
+	if len(dst) < n {
+		return nil, fmt.Errorf("decoded %d bytes, expected %d", len(dst), n)
+	}
+	return dst[:n], nil
+}
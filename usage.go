@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"io/fs"
+	"strings"
+	"sync"
+	"time"
+)
+
+// walking storage gets slow with enough files so the totals are kept
+// around for a bit, writes throw them away early
+const usageTTL = 30 * time.Second
+
+// storageUsage is how much space the stored files take up
+type storageUsage struct {
+	Bytes int64 `json:"bytes"`
+	Files int   `json:"files"`
+	sizes map[string]int64
+}
+
+type usageCache struct {
+	mu    sync.Mutex
+	at    time.Time
+	usage *storageUsage
+}
+
+// get gives the current usage, walking storage again if the last walk
+// is too old or something's been written since
+func (c *usageCache) get(ctx context.Context, storage fileStorage) (*storageUsage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.usage != nil && time.Since(c.at) < usageTTL {
+		return c.usage, nil
+	}
+
+	entries, err := fs.ReadDir(storage.FS(ctx), ".")
+	if err != nil {
+		return nil, err
+	}
+	u := &storageUsage{sizes: map[string]int64{}}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") || !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			// deleted since it was listed
+			continue
+		}
+		u.Bytes += info.Size()
+		u.Files++
+		u.sizes[entry.Name()] = info.Size()
+	}
+	c.usage, c.at = u, time.Now()
+	return u, nil
+}
+
+func (c *usageCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.usage = nil
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// how bad a finding is, errors break the standard outright while
+// warnings are things most readers will cope with
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+// finding is one problem validation turned up
+type finding struct {
+	Severity string `json:"severity"`
+	Tag      string `json:"tag,omitempty"`
+	Keyword  string `json:"keyword,omitempty"`
+	Message  string `json:"message"`
+}
+
+func newFinding(severity string, t *tag.Tag, format string, args ...any) finding {
+	f := finding{Severity: severity, Message: fmt.Sprintf(format, args...)}
+	if t != nil {
+		f.Tag = t.String()
+		if info, err := tag.Find(*t); err == nil {
+			f.Keyword = info.Name
+		}
+	}
+	return f
+}
+
+// type 1 attributes of the modules every composite instance carries,
+// PS3.3 C.12.1 sop common, C.7.2.1 general study and C.7.3.1 general
+// series, the per sop class iods go well beyond this but these are
+// what everything downstream keys off
+var requiredAttrs = []tag.Tag{
+	tag.SOPClassUID,
+	tag.SOPInstanceUID,
+	tag.StudyInstanceUID,
+	tag.SeriesInstanceUID,
+	tag.Modality,
+}
+
+// type 1 attributes of the image pixel module, PS3.3 C.7.6.3, which
+// anything with pixel data has to include
+var pixelAttrs = []tag.Tag{
+	tag.SamplesPerPixel,
+	tag.PhotometricInterpretation,
+	tag.Rows,
+	tag.Columns,
+	tag.BitsAllocated,
+	tag.BitsStored,
+	tag.HighBit,
+	tag.PixelRepresentation,
+}
+
+// validate checks ds against the parts of the standard that commonly
+// go wrong, it expects pixel data to have been skipped while parsing
+// since only its length gets looked at
+func validate(ds dicom.Dataset) []finding {
+	findings := []finding{}
+	pixels, err := ds.FindElementByTag(tag.PixelData)
+	hasPixels := err == nil
+
+	required := requiredAttrs
+	if hasPixels {
+		required = append(required[:len(required):len(required)], pixelAttrs...)
+	}
+	for _, t := range required {
+		elem, err := ds.FindElementByTag(t)
+		if err != nil {
+			findings = append(findings, newFinding(severityError, &t, "required attribute is missing"))
+			continue
+		}
+		if elem.ValueLength == 0 {
+			findings = append(findings, newFinding(severityError, &t, "required attribute is empty"))
+		}
+	}
+
+	findings = append(findings, validateValues(ds.Elements)...)
+	if hasPixels {
+		findings = append(findings, validatePixels(ds, pixels)...)
+	} else if _, ok := datasetInt(ds, tag.Rows); ok {
+		// the parser quietly stops at the end of the file, so pixel
+		// data cut off partway just looks absent
+		findings = append(findings, newFinding(severityError, &tag.PixelData, "image attributes are present but pixel data isn't, the file may be truncated"))
+	}
+	return findings
+}
+
+// validateValues checks every string value fits its VR, walking down
+// into sequences
+func validateValues(elems []*dicom.Element) []finding {
+	var findings []finding
+	for _, elem := range elems {
+		switch vals := elem.Value.GetValue().(type) {
+		case []string:
+			vr := elem.RawValueRepresentation
+			for _, v := range vals {
+				v = strings.TrimRight(v, "\x00 ")
+				if maxLen, ok := vrMaxLen[vr]; ok && len(v) > maxLen {
+					findings = append(findings, newFinding(severityError, &elem.Tag, "%q is longer than %s allows (%d)", v, vr, maxLen))
+					continue
+				}
+				if v == "" {
+					continue
+				}
+				if !validVRString(vr, strings.TrimSpace(v)) {
+					findings = append(findings, newFinding(severityError, &elem.Tag, "%q isn't a valid %s", v, vr))
+					continue
+				}
+				if vr == "UI" && !validUID(v) {
+					findings = append(findings, newFinding(severityError, &elem.Tag, "uid %q has a component with a leading zero", v))
+				}
+			}
+		case []*dicom.SequenceItemValue:
+			for _, item := range vals {
+				findings = append(findings, validateValues(item.GetValue().([]*dicom.Element))...)
+			}
+		}
+	}
+	return findings
+}
+
+// validUID checks what the VR pattern doesn't, PS3.5 9.1 forbids
+// leading zeros in any component other than a lone 0
+func validUID(u string) bool {
+	for _, c := range strings.Split(u, ".") {
+		if len(c) > 1 && c[0] == '0' {
+			return false
+		}
+	}
+	return true
+}
+
+// validatePixels checks native pixel data is exactly as long as the
+// image pixel module says it should be, encapsulated data has no
+// length to compare against
+func validatePixels(ds dicom.Dataset, pixels *dicom.Element) []finding {
+	if pixels.ValueLength == tag.VLUndefinedLength {
+		return nil
+	}
+
+	rows, rok := datasetInt(ds, tag.Rows)
+	cols, cok := datasetInt(ds, tag.Columns)
+	samples, sok := datasetInt(ds, tag.SamplesPerPixel)
+	bits, bok := datasetInt(ds, tag.BitsAllocated)
+	if !rok || !cok || !sok || !bok {
+		// already reported as missing
+		return nil
+	}
+	frames := 1
+	if s := strings.TrimSpace(datasetString(ds, tag.NumberOfFrames)); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 {
+			return []finding{newFinding(severityError, &tag.NumberOfFrames, "%q isn't a valid frame count", s)}
+		}
+		frames = n
+	}
+
+	want := (rows*cols*samples*frames*bits + 7) / 8
+	got := int(pixels.ValueLength)
+	switch {
+	case got == want || got == want+1 && want%2 == 1:
+		// odd lengths get padded to even
+		return nil
+	case got < want:
+		return []finding{newFinding(severityError, &pixels.Tag, "pixel data is %d bytes but %d rows, %d columns, %d samples and %d frames at %d bits need %d", got, rows, cols, samples, frames, bits, want)}
+	default:
+		return []finding{newFinding(severityWarning, &pixels.Tag, "pixel data is %d bytes, %d more than %d rows, %d columns, %d samples and %d frames at %d bits need", got, got-want, rows, cols, samples, frames, bits)}
+	}
+}
+
+// validationReport is the response for a validation, a file that
+// doesn't parse at all fails with that as its only finding
+func validationReport(ds dicom.Dataset, parseErr error) gin.H {
+	findings := []finding{newFinding(severityError, nil, "can't parse: %v", parseErr)}
+	if parseErr == nil {
+		findings = validate(ds)
+	}
+	valid := !slices.ContainsFunc(findings, func(f finding) bool {
+		return f.Severity == severityError
+	})
+	return gin.H{"valid": valid, "findings": findings}
+}
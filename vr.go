@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// longest value each string VR allows, PS3.5 6.2
+var vrMaxLen = map[string]int{
+	"AE": 16, "AS": 4, "CS": 16, "DA": 8, "DS": 16, "DT": 26, "IS": 12,
+	"LO": 64, "LT": 10240, "PN": 64 * 3, "SH": 16, "ST": 1024, "TM": 14,
+	"UC": math.MaxInt32, "UI": 64, "UR": math.MaxInt32, "UT": math.MaxInt32,
+}
+
+var (
+	vrAS = regexp.MustCompile(`^[0-9]{3}[DWMY]$`)
+	vrCS = regexp.MustCompile(`^[A-Z0-9 _]*$`)
+	vrTM = regexp.MustCompile(`^[0-9]{2}([0-9]{2}([0-9]{2}(\.[0-9]{1,6})?)?)?$`)
+	vrDT = regexp.MustCompile(`^[0-9]{4,14}(\.[0-9]{1,6})?([+-][0-9]{4})?$`)
+	vrUI = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*$`)
+)
+
+// ranges of the binary integer VRs
+var vrIntRange = map[string][2]int{
+	"US": {0, math.MaxUint16},
+	"SS": {math.MinInt16, math.MaxInt16},
+	"UL": {0, math.MaxUint32},
+	"SL": {math.MinInt32, math.MaxInt32},
+}
+
+// jsonValues reads a value given as json, either a single string or
+// number or a list of them for multi-valued elements
+func jsonValues(raw json.RawMessage) ([]string, error) {
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err != nil {
+		list = []json.RawMessage{raw}
+	}
+
+	vals := make([]string, len(list))
+	for i, v := range list {
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			vals[i] = s
+			continue
+		}
+		var n json.Number
+		if err := json.Unmarshal(v, &n); err != nil {
+			return nil, errors.New("values have to be strings or numbers")
+		}
+		vals[i] = n.String()
+	}
+	return vals, nil
+}
+
+// vrValue checks vals are valid for vr and converts them into the
+// form dicom.NewValue wants for it
+func vrValue(vr string, vals []string) (any, error) {
+	if lo, ok := vrIntRange[vr]; ok {
+		ints := make([]int, len(vals))
+		for i, v := range vals {
+			n, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil || n < lo[0] || n > lo[1] {
+				return nil, fmt.Errorf("%q isn't a valid %s", v, vr)
+			}
+			ints[i] = n
+		}
+		return ints, nil
+	}
+	if vr == "FL" || vr == "FD" {
+		floats := make([]float64, len(vals))
+		for i, v := range vals {
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q isn't a valid %s", v, vr)
+			}
+			floats[i] = f
+		}
+		return floats, nil
+	}
+
+	maxLen, ok := vrMaxLen[vr]
+	if !ok {
+		return nil, fmt.Errorf("values with VR %s can't be set", vr)
+	}
+	for _, v := range vals {
+		if len(v) > maxLen {
+			return nil, fmt.Errorf("%q is longer than %s allows (%d)", v, vr, maxLen)
+		}
+		if v != "" && !validVRString(vr, strings.TrimSpace(v)) {
+			return nil, fmt.Errorf("%q isn't a valid %s", v, vr)
+		}
+	}
+	return vals, nil
+}
+
+func validVRString(vr, v string) bool {
+	switch vr {
+	case "AS":
+		return vrAS.MatchString(v)
+	case "CS":
+		return vrCS.MatchString(v)
+	case "DA":
+		_, err := time.Parse("20060102", v)
+		return err == nil
+	case "DS":
+		_, err := strconv.ParseFloat(v, 64)
+		return err == nil
+	case "DT":
+		return vrDT.MatchString(v)
+	case "IS":
+		_, err := strconv.ParseInt(v, 10, 32)
+		return err == nil
+	case "TM":
+		return vrTM.MatchString(v)
+	case "UI":
+		return vrUI.MatchString(v)
+	}
+	return true
+}
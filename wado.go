@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// wadoRequest is a WADO-URI retrieval, which names an instance by
+// its uids and asks for it either as it's stored or rendered
+type wadoRequest struct {
+	study, series, object string
+	// raw is for the dicom file itself rather than an image of it
+	raw  bool
+	opts imageOptions
+}
+
+// queryWADO reads a WADO-URI request off the query, frameNumber
+// counts from 1 and rows and columns both bound the rendered size
+func queryWADO(ctx *gin.Context) (req wadoRequest, err error) {
+	if rt := ctx.Query("requestType"); rt != "WADO" {
+		return req, NewStatusError(http.StatusBadRequest, fmt.Errorf("unsupported requestType %q", rt))
+	}
+	req.study, req.series, req.object = ctx.Query("studyUID"), ctx.Query("seriesUID"), ctx.Query("objectUID")
+	if req.study == "" || req.series == "" || req.object == "" {
+		return req, NewStatusError(http.StatusBadRequest, errors.New("studyUID, seriesUID and objectUID are required"))
+	}
+
+	// contentType lists what's acceptable in order of preference
+	contentType := ctx.DefaultQuery("contentType", "image/jpeg")
+	var format string
+	for ct := range strings.SplitSeq(contentType, ",") {
+		ct, _, _ = mime.ParseMediaType(strings.TrimSpace(ct))
+		if ct == "application/dicom" || ct == "image/jpeg" || ct == "image/png" {
+			format = ct
+			break
+		}
+	}
+	switch format {
+	case "":
+		return req, NewStatusError(http.StatusNotAcceptable, fmt.Errorf("unsupported contentType %q", contentType))
+	case "application/dicom":
+		req.raw = true
+		return
+	}
+
+	req.opts.enc, err = formatEncoder(ctx, format, "imageQuality")
+	if err != nil {
+		return
+	}
+	n, err := queryInt(ctx, "frameNumber", 1)
+	if err != nil {
+		return
+	}
+	if n < 1 {
+		return req, NewStatusError(http.StatusBadRequest, errors.New("frameNumber must be at least 1"))
+	}
+	req.opts.frame = n - 1
+	req.opts.rescale = true
+	req.opts.win, err = queryWindow(ctx)
+	if err != nil {
+		return
+	}
+	for _, key := range []string{"rows", "columns"} {
+		dim, err := queryInt(ctx, key, 0)
+		if err != nil {
+			return req, err
+		}
+		if dim > 0 && (req.opts.maxDim == 0 || dim < req.opts.maxDim) {
+			req.opts.maxDim = dim
+		}
+	}
+	return
+}
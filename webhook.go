@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// delivery is retried with doubling backoff, after the last attempt
+// the event is logged and dropped
+const (
+	webhookAttempts = 5
+	webhookBackoff  = time.Second
+	webhookTimeout  = 10 * time.Second
+	// events waiting to go out, past this new ones are dropped rather
+	// than holding up uploads
+	webhookQueue = 1024
+)
+
+// event is what gets posted to the webhook once a file is stored or
+// deleted
+type event struct {
+	Type           string    `json:"type"`
+	ID             string    `json:"id"`
+	SOPInstanceUID string    `json:"sopInstanceUID,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// webhook posts events to a url in the background, one at a time so
+// they arrive in the order they happened, a nil webhook drops
+// everything
+type webhook struct {
+	url    string
+	client *http.Client
+	events chan event
+	// done is closed once run has sent everything it was given
+	done chan struct{}
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func newWebhook(url string) *webhook {
+	if url == "" {
+		return nil
+	}
+	w := &webhook{url: url, client: &http.Client{Timeout: webhookTimeout}, events: make(chan event, webhookQueue), done: make(chan struct{})}
+	go w.run()
+	return w
+}
+
+// notify queues an event without waiting on it
+func (w *webhook) notify(typ, id, sop string) {
+	if w == nil {
+		return
+	}
+	ev := event{Type: typ, ID: id, SOPInstanceUID: sop, Timestamp: time.Now().UTC()}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		slog.Error("webhook closed, dropping event", slog.String("type", typ), slog.String("id", id))
+		return
+	}
+	select {
+	case w.events <- ev:
+	default:
+		slog.Error("webhook queue full, dropping event", slog.String("type", typ), slog.String("id", id))
+	}
+}
+
+// close stops taking events and waits up to wait for the ones still
+// queued to go out, whatever's left after that is lost
+func (w *webhook) close(wait time.Duration) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.events)
+	}
+	w.mu.Unlock()
+
+	select {
+	case <-w.done:
+	case <-time.After(wait):
+		slog.Error("webhook queue not drained in time, dropping events", slog.Int("events", len(w.events)))
+	}
+}
+
+func (w *webhook) run() {
+	defer close(w.done)
+	for ev := range w.events {
+		body, err := json.Marshal(ev)
+		if err != nil {
+			continue
+		}
+
+		backoff := webhookBackoff
+		for attempt := 1; ; attempt++ {
+			err = w.post(body)
+			if err == nil {
+				break
+			}
+			if attempt == webhookAttempts {
+				slog.Error("webhook delivery failed", slog.String("type", ev.Type), slog.String("id", ev.ID), slog.Int("attempts", attempt), slog.Any("error", err))
+				break
+			}
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+}
+
+func (w *webhook) post(body []byte) error {
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhookCloseDrainsQueue(t *testing.T) {
+	var got atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		got.Add(1)
+	}))
+	defer srv.Close()
+
+	w := newWebhook(srv.URL)
+	for range 3 {
+		w.notify("stored", "base", "")
+	}
+	w.close(5 * time.Second)
+	if n := got.Load(); n != 3 {
+		t.Errorf("delivered %d of 3 events before close returned", n)
+	}
+
+	// anything after close is dropped rather than panicking
+	w.notify("deleted", "base", "")
+}